@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeAirMediaReceiverNotFound = "noent"
+)
+
+func (c *client) GetAirMediaConfiguration(ctx context.Context) (result types.AirMediaConfiguration, err error) {
+	response, err := c.get(ctx, "airmedia/config/", c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to GET airmedia/config/ endpoint: %w", err)
+	}
+
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return types.AirMediaConfiguration{}, fmt.Errorf("failed to get AirMedia configuration from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateAirMediaConfiguration(ctx context.Context, payload types.AirMediaConfiguration) (result types.AirMediaConfiguration, err error) {
+	response, err := c.put(ctx, "airmedia/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to PUT airmedia/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update AirMedia configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListAirMediaReceivers returns the AirMedia receivers available on the local network.
+func (c *client) ListAirMediaReceivers(ctx context.Context) ([]types.AirMediaReceiver, error) {
+	response, err := c.get(ctx, "airmedia/receivers/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET airmedia/receivers/ endpoint: %w", err)
+	}
+
+	result := make([]types.AirMediaReceiver, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to list AirMedia receivers from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// SendAirMediaRequest sends a request to start or stop playing a media to the AirMedia receiver with the given name.
+func (c *client) SendAirMediaRequest(ctx context.Context, receiver string, payload types.AirMediaReceiverRequest) error {
+	response, err := c.post(ctx, fmt.Sprintf("airmedia/receivers/%s/", url.PathEscape(receiver)), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeAirMediaReceiverNotFound {
+			return ErrAirMediaReceiverNotFound
+		}
+
+		return fmt.Errorf("failed to POST airmedia/receivers/%s/ endpoint: %w", receiver, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("airmedia", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("getting the AirMedia configuration", func() {
+		returnedConfig := new(types.AirMediaConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetAirMediaConfiguration(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/airmedia/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": true}
+						}`),
+					),
+				)
+			})
+			It("should return the correct AirMedia configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.AirMediaConfiguration{Enabled: true}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the AirMedia configuration", func() {
+		returnedConfig := new(types.AirMediaConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateAirMediaConfiguration(ctx, types.AirMediaConfiguration{Enabled: true, Password: "secret"})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/airmedia/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"enabled": true, "password": "secret"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": true}
+						}`),
+					),
+				)
+			})
+			It("should return the updated AirMedia configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.AirMediaConfiguration{Enabled: true}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the AirMedia receivers", func() {
+		returnedReceivers := new([]types.AirMediaReceiver)
+		JustBeforeEach(func() {
+			*returnedReceivers, *returnedErr = freeboxClient.ListAirMediaReceivers(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/airmedia/receivers/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"name": "Freebox Player",
+									"password_protected": false,
+									"capabilities": {"photo": true, "screen": false, "audio": false, "video": true}
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct receivers", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedReceivers).To(Equal([]types.AirMediaReceiver{
+					{
+						Name: "Freebox Player",
+						Capabilities: types.AirMediaReceiverCapabilities{
+							Photo: true,
+							Video: true,
+						},
+					},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/airmedia/receivers/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedReceivers).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("sending a request to an AirMedia receiver", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.SendAirMediaRequest(ctx, "Freebox Player", types.AirMediaReceiverRequest{
+				Action:    types.AirMediaActionStart,
+				MediaType: types.AirMediaMediaTypeVideo,
+				Media:     "http://example.com/video.mp4",
+				Position:  42,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/airmedia/receivers/Freebox Player/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"action": "start",
+							"media_type": "video",
+							"media": "http://example.com/video.mp4",
+							"position": 42
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the receiver is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/airmedia/receivers/Freebox Player/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrAirMediaReceiverNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrAirMediaReceiverNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -16,9 +16,9 @@ func (c *client) APIVersion(ctx context.Context) (version types.APIVersion, err
 		return version, fmt.Errorf("failed to build request: %w", err)
 	}
 
-	response, err := c.httpClient.Do(request)
+	response, err := c.stream(request)
 	if err != nil {
-		return version, fmt.Errorf("failed to perform request: %w", err)
+		return version, err
 	}
 
 	defer func() {
@@ -35,12 +35,6 @@ func (c *client) APIVersion(ctx context.Context) (version types.APIVersion, err
 		return version, fmt.Errorf("failed to read response body: %w", err)
 	}
 
-	if response.StatusCode != http.StatusOK {
-		return version, fmt.Errorf(
-			"failed with status '%d': server returned '%s'", response.StatusCode, string(body),
-		)
-	}
-
 	if err = json.Unmarshal(body, &version); err != nil {
 		return version, fmt.Errorf("failed to unmarshal response body '%s': %w", string(body), err)
 	}
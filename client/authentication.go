@@ -12,6 +12,11 @@ import (
 	"github.com/nikolalohinski/free-go/types"
 )
 
+const (
+	codeAuthRequired   = "auth_required"
+	codeInvalidSession = "invalid_session"
+)
+
 type authorizationRequest struct {
 	AppID      string `json:"app_id"`
 	AppName    string `json:"app_name"`
@@ -117,31 +122,120 @@ type sessionResponse struct {
 	PasswordSalt string            `json:"password_salt"`
 }
 
-func (c *client) Login(ctx context.Context) (permissions types.Permissions, err error) {
+// Login opens a new session. Concurrent calls share a single login: only the first one reaches the
+// Freebox and the others wait for its outcome, or for their own context to be done.
+func (c *client) Login(ctx context.Context) (types.Permissions, error) {
+	call, err := c.refreshSession(ctx)
+	if err != nil {
+		return types.Permissions{}, err
+	}
+
+	return call.permissions, nil
+}
+
+// refreshSession joins the login in flight, or starts a new one when there is none.
+func (c *client) refreshSession(ctx context.Context) (*loginCall, error) {
+	c.sessionMutex.Lock()
+	call := c.login
+	if call == nil {
+		call = &loginCall{done: make(chan struct{})}
+		c.login = call
+
+		go c.runLogin(ctx, call)
+	}
+	c.sessionMutex.Unlock()
+
+	select {
+	case <-call.done:
+		return call, call.err
+	case <-ctx.Done():
+		return nil, fmt.Errorf("context was canceled while waiting for login: %w", ctx.Err())
+	}
+}
+
+// runLogin opens the session shared by every request waiting for it. It is detached from the context of the
+// request that started it, so that canceling that request does not fail the others.
+func (c *client) runLogin(ctx context.Context, call *loginCall) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), LoginTimeout)
+	defer cancel()
+
+	call.session, call.permissions, call.err = c.openSession(withOperation(ctx, "Login"))
+
+	c.sessionMutex.Lock()
+	if call.err == nil {
+		c.session = call.session
+	}
+	c.login = nil
+	c.sessionMutex.Unlock()
+
+	close(call.done)
+}
+
+// renewSession discards the session with the given token, which the box does not accept anymore, and
+// returns a new one. If the session has already been renewed by a concurrent request, it is reused.
+func (c *client) renewSession(ctx context.Context, staleToken string) (*session, error) {
+	c.sessionMutex.Lock()
+	if c.session != nil {
+		if c.session.token != staleToken && time.Now().Before(c.session.expires) {
+			renewed := c.session
+			c.sessionMutex.Unlock()
+
+			return renewed, nil
+		}
+
+		if c.session.token == staleToken {
+			c.session = nil
+		}
+	}
+	c.sessionMutex.Unlock()
+
+	call, err := c.refreshSession(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return call.session, nil
+}
+
+// currentSession returns the current session if it has not expired yet.
+func (c *client) currentSession() (current *session, expired bool) {
+	c.sessionMutex.Lock()
+	defer c.sessionMutex.Unlock()
+
+	if c.session == nil {
+		return nil, false
+	}
+
+	if time.Now().After(c.session.expires) {
+		return nil, true
+	}
+
+	return c.session, false
+}
+
+func (c *client) openSession(ctx context.Context) (*session, types.Permissions, error) {
 	if c.appID == nil {
-		return permissions, ErrAppIDIsNotSet
+		return nil, types.Permissions{}, ErrAppIDIsNotSet
 	}
 
 	if c.privateToken == nil {
-		return permissions, ErrPrivateTokenIsNotSet
+		return nil, types.Permissions{}, ErrPrivateTokenIsNotSet
 	}
 
 	challenge, err := c.getLoginChallenge(ctx)
 	if err != nil {
-		return permissions, fmt.Errorf("failed to get login challenge: %w", err)
+		return nil, types.Permissions{}, fmt.Errorf("failed to get login challenge: %w", err)
 	}
 
 	sessionResponse, err := c.getSession(ctx, challenge.Challenge)
 	if err != nil {
-		return permissions, fmt.Errorf("failed to get a session: %w", err)
+		return nil, types.Permissions{}, fmt.Errorf("failed to get a session: %w", err)
 	}
 
-	c.session = &session{
+	return &session{
 		token:   sessionResponse.SessionToken,
 		expires: time.Now().Add(LoginSessionTTL),
-	}
-
-	return sessionResponse.Permissions, nil
+	}, sessionResponse.Permissions, nil
 }
 
 func (c *client) getLoginChallenge(ctx context.Context) (*loginChallenge, error) {
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
@@ -699,4 +701,148 @@ var _ = Describe("authentication", func() {
 			})
 		})
 	})
+	Context("when the client is used concurrently", func() {
+		const (
+			workers      = 50
+			sessionToken = "EfETzVibY7K5vZVsq+MjtD6pDJoAaYQiqyXwS5kFvooTczPMk7Tz+6//aTe9zZNy"
+		)
+		var (
+			challengeRequests = new(atomic.Int64)
+			sessionRequests   = new(atomic.Int64)
+			sessionStatus     int
+			errs              []error
+		)
+		BeforeEach(func() {
+			challengeRequests.Store(0)
+			sessionRequests.Store(0)
+			sessionStatus = http.StatusOK
+
+			freeboxClient = freeboxClient.WithPrivateToken(privateToken)
+
+			server.RouteToHandler(http.MethodGet, fmt.Sprintf("/api/%s/login", version), func(w http.ResponseWriter, r *http.Request) {
+				challengeRequests.Add(1)
+				// Leave enough time for every worker to wait on the login in flight
+				time.Sleep(100 * time.Millisecond)
+				ghttp.RespondWith(http.StatusOK, `{
+					"success": true,
+					"result": {"logged_in": false, "challenge": "9Va31tSgQWM853j0kSCtBUyzYNhPN7IY"}
+				}`)(w, r)
+			})
+			server.RouteToHandler(http.MethodPost, fmt.Sprintf("/api/%s/login/session", version), func(w http.ResponseWriter, r *http.Request) {
+				sessionRequests.Add(1)
+				if sessionStatus != http.StatusOK {
+					ghttp.RespondWith(sessionStatus, `{"success": false, "error_code": "invalid_token"}`)(w, r)
+
+					return
+				}
+				ghttp.RespondWith(http.StatusOK, `{
+					"success": true,
+					"result": {"session_token": "`+sessionToken+`", "challenge": "9Va31tSgQWM853j0kSCtBUyzYNhPN7IY", "permissions": {}}
+				}`)(w, r)
+			})
+			server.RouteToHandler(http.MethodGet, fmt.Sprintf("/api/%s/system/", version), ghttp.CombineHandlers(
+				verifyAuth(sessionToken),
+				ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"uptime_val": 42}}`),
+			))
+		})
+		JustBeforeEach(func() {
+			errs = make([]error, workers)
+
+			wg := sync.WaitGroup{}
+			for index := range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, errs[index] = freeboxClient.GetSystemInfo(ctx)
+				}()
+			}
+			wg.Wait()
+		})
+		Context("default", func() {
+			It("should login only once for every request", func() {
+				for _, err := range errs {
+					Expect(err).To(BeNil())
+				}
+				Expect(challengeRequests.Load()).To(Equal(int64(1)))
+				Expect(sessionRequests.Load()).To(Equal(int64(1)))
+			})
+		})
+		Context("when the session has expired", func() {
+			BeforeEach(func() {
+				previous := client.LoginSessionTTL
+				DeferCleanup(func() {
+					client.LoginSessionTTL = previous
+				})
+
+				// Open a session that is already expired
+				client.LoginSessionTTL = -time.Minute
+				_, err := freeboxClient.Login(ctx)
+				Expect(err).To(BeNil())
+				client.LoginSessionTTL = time.Minute
+			})
+			It("should login again only once for every request", func() {
+				for _, err := range errs {
+					Expect(err).To(BeNil())
+				}
+				Expect(sessionRequests.Load()).To(Equal(int64(2)))
+			})
+		})
+		Context("when the login fails", func() {
+			BeforeEach(func() {
+				sessionStatus = http.StatusForbidden
+			})
+			It("should return the error of the shared login to every request", func() {
+				for _, err := range errs {
+					Expect(err).ToNot(BeNil())
+				}
+				Expect(sessionRequests.Load()).To(Equal(int64(1)))
+			})
+		})
+		Context("when the context of a request is canceled while a login is in flight", func() {
+			var (
+				otherClient client.Client
+				startLogin  = func(ctx context.Context) chan error {
+					done := make(chan error, 1)
+					go func() {
+						_, err := otherClient.GetSystemInfo(ctx)
+						done <- err
+					}()
+
+					return done
+				}
+			)
+			BeforeEach(func() {
+				// The workers already opened a session on the shared client
+				otherClient = Must(client.New(*endpoint, version)).
+					WithAppID(appID).
+					WithPrivateToken(privateToken)
+			})
+			JustBeforeEach(func() {
+				challengeRequests.Store(0) // Forget about the login of the workers
+			})
+			It("should stop waiting for the login", func() {
+				live := startLogin(ctx)
+				Eventually(challengeRequests.Load).Should(Equal(int64(1)))
+
+				waiting, cancel := context.WithCancel(ctx)
+				canceled := startLogin(waiting)
+				cancel()
+
+				Eventually(canceled).WithTimeout(50 * time.Millisecond).Should(Receive(MatchError(context.Canceled)))
+				Eventually(live).Should(Receive(BeNil()))
+			})
+			It("should not fail the other requests when the one that started the login is canceled", func() {
+				starting, cancel := context.WithCancel(ctx)
+				started := startLogin(starting)
+				Eventually(challengeRequests.Load).Should(Equal(int64(1)))
+
+				live := startLogin(ctx)
+				cancel()
+
+				Eventually(started).Should(Receive(MatchError(context.Canceled)))
+				Eventually(live).Should(Receive(BeNil()))
+				Expect(challengeRequests.Load()).To(Equal(int64(1)))
+			})
+		})
+	})
 })
@@ -0,0 +1,97 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeCallNotFound = "noent"
+)
+
+// ListCallEntries returns every entry of the call log.
+func (c *client) ListCallEntries(ctx context.Context) ([]types.CallEntry, error) {
+	response, err := c.get(ctx, "call/log/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET call/log/ endpoint: %w", err)
+	}
+
+	result := make([]types.CallEntry, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get call entries from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetCallEntry returns the call log entry with the given identifier.
+func (c *client) GetCallEntry(ctx context.Context, identifier int64) (entry types.CallEntry, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("call/log/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeCallNotFound {
+			return entry, ErrCallEntryNotFound
+		}
+
+		return entry, fmt.Errorf("failed to GET call/log/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &entry); err != nil {
+		return entry, fmt.Errorf("failed to get call entry from generic response: %w", err)
+	}
+
+	return entry, nil
+}
+
+// UpdateCallEntry updates the call log entry with the given identifier.
+func (c *client) UpdateCallEntry(ctx context.Context, identifier int64, payload types.CallEntryPayload) (entry types.CallEntry, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("call/log/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeCallNotFound {
+			return entry, ErrCallEntryNotFound
+		}
+
+		return entry, fmt.Errorf("failed to PUT call/log/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &entry); err != nil {
+		return entry, fmt.Errorf("failed to get updated call entry from generic response: %w", err)
+	}
+
+	return entry, nil
+}
+
+// DeleteCallEntry deletes the call log entry with the given identifier.
+func (c *client) DeleteCallEntry(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("call/log/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeCallNotFound {
+			return ErrCallEntryNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE call/log/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// DeleteAllCallEntries empties the call log.
+func (c *client) DeleteAllCallEntries(ctx context.Context) error {
+	if _, err := c.post(ctx, "call/log/delete_all/", nil, c.withSession(ctx)); err != nil {
+		return fmt.Errorf("failed to POST call/log/delete_all/ endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// MarkAllCallEntriesAsRead acknowledges every entry of the call log.
+func (c *client) MarkAllCallEntriesAsRead(ctx context.Context) error {
+	if _, err := c.post(ctx, "call/log/mark_all_as_read/", nil, c.withSession(ctx)); err != nil {
+		return fmt.Errorf("failed to POST call/log/mark_all_as_read/ endpoint: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,332 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("call", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing call entries", func() {
+		returnedEntries := new([]types.CallEntry)
+		JustBeforeEach(func() {
+			*returnedEntries, *returnedErr = freeboxClient.ListCallEntries(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/call/log/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"number": "0102030405",
+									"type": "missed",
+									"id": 42,
+									"duration": 0,
+									"datetime": 1355048186,
+									"contact_id": 0,
+									"line_id": 0,
+									"name": "0102030405",
+									"new": true
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct call entries", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedEntries).To(Equal([]types.CallEntry{
+					{
+						ID:       42,
+						Type:     types.CallTypeMissed,
+						Datetime: types.Timestamp{Time: time.Unix(1355048186, 0).UTC()},
+						Number:   "0102030405",
+						Name:     "0102030405",
+						New:      true,
+					},
+				}))
+			})
+		})
+		Context("when the call log is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/call/log/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedEntries).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a call entry", func() {
+		returnedEntry := new(types.CallEntry)
+		JustBeforeEach(func() {
+			*returnedEntry, *returnedErr = freeboxClient.GetCallEntry(ctx, 42)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/call/log/42", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"number": "0102030405",
+								"type": "accepted",
+								"id": 42,
+								"duration": 67,
+								"datetime": 1355048186,
+								"contact_id": 3,
+								"line_id": 0,
+								"name": "Pierre",
+								"new": false
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct call entry", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedEntry).To(Equal(types.CallEntry{
+					ID:        42,
+					Type:      types.CallTypeAccepted,
+					Datetime:  types.Timestamp{Time: time.Unix(1355048186, 0).UTC()},
+					Number:    "0102030405",
+					Name:      "Pierre",
+					Duration:  67,
+					ContactID: 3,
+				}))
+			})
+		})
+		Context("when the call entry is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/call/log/42", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrCallEntryNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrCallEntryNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a call entry", func() {
+		returnedEntry := new(types.CallEntry)
+		JustBeforeEach(func() {
+			*returnedEntry, *returnedErr = freeboxClient.UpdateCallEntry(ctx, 42, types.CallEntryPayload{New: false})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/call/log/42", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"new": false}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 42,
+								"type": "outgoing",
+								"datetime": 1355048186,
+								"number": "0102030405",
+								"new": false
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated call entry", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedEntry.Type).To(Equal(types.CallTypeOutgoing))
+				Expect(returnedEntry.New).To(BeFalse())
+			})
+		})
+		Context("when the call entry is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/call/log/42", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrCallEntryNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrCallEntryNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("deleting a call entry", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteCallEntry(ctx, 42)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/call/log/42", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the call entry is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/call/log/42", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrCallEntryNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrCallEntryNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("deleting all call entries", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteAllCallEntries(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/call/log/delete_all/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("marking all call entries as read", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.MarkAllCallEntriesAsRead(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/call/log/mark_all_as_read/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
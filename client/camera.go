@@ -0,0 +1,232 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeCameraNotFound = "noent"
+)
+
+// ListCameras returns every camera paired with the Freebox.
+func (c *client) ListCameras(ctx context.Context) ([]types.Camera, error) {
+	response, err := c.get(ctx, "camera/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET camera/ endpoint: %w", err)
+	}
+
+	result := make([]types.Camera, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get cameras from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetCameraSnapshot returns the current snapshot of the given camera. The
+// caller is responsible for draining the returned content.
+func (c *client) GetCameraSnapshot(ctx context.Context, identifier int64) (result types.File, err error) {
+	endpoint := fmt.Sprintf("camera/%d/snapshot", identifier)
+
+	httpResponse, err := c.openCameraResource(ctx, c.base.JoinPath(endpoint))
+	if err != nil {
+		return result, fmt.Errorf("failed to GET %s endpoint: %w", endpoint, err)
+	}
+
+	return fileFromHTTPResponse(httpResponse)
+}
+
+// GetCameraStream returns the HLS playlist of the live stream of the given
+// camera, along with a reader over the concatenated content of its segments.
+// Only the segments listed in the playlist at the time of the call are read;
+// the reader must be closed by the caller.
+func (c *client) GetCameraStream(ctx context.Context, identifier int64) (types.M3U8Playlist, io.ReadCloser, error) {
+	endpoint := fmt.Sprintf("camera/%d/stream.m3u8", identifier)
+	playlistURL := c.base.JoinPath(endpoint)
+
+	httpResponse, err := c.openCameraResource(ctx, playlistURL)
+	if err != nil {
+		return types.M3U8Playlist{}, nil, fmt.Errorf("failed to GET %s endpoint: %w", endpoint, err)
+	}
+	defer httpResponse.Body.Close()
+
+	playlist, err := parseM3U8Playlist(httpResponse.Body)
+	if err != nil {
+		return types.M3U8Playlist{}, nil, fmt.Errorf("failed to parse playlist: %w", err)
+	}
+
+	segments := make([]*url.URL, len(playlist.Segments))
+	for i, segment := range playlist.Segments {
+		reference, err := url.Parse(segment.URI)
+		if err != nil {
+			return types.M3U8Playlist{}, nil, fmt.Errorf("failed to parse segment URI %q: %w", segment.URI, err)
+		}
+
+		segments[i] = playlistURL.ResolveReference(reference)
+		if segments[i].Scheme != c.base.Scheme || segments[i].Host != c.base.Host {
+			// The session token must not be sent to another host
+			return types.M3U8Playlist{}, nil, fmt.Errorf("segment URI %q is not served by the freebox", segment.URI)
+		}
+	}
+
+	// Segments are read after the method returned, so the calls fetching them are named explicitly
+	return playlist, &cameraSegmentsReader{
+		ctx:      withOperation(ctx, "GetCameraStream"),
+		client:   c,
+		segments: segments,
+	}, nil
+}
+
+// openCameraResource performs an authenticated GET on a raw (non JSON
+// enveloped) camera resource. On success, the caller owns the response body.
+func (c *client) openCameraResource(ctx context.Context, target *url.URL) (*http.Response, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, target.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to forge new request: %w", err)
+	}
+
+	if err := c.withSession(ctx)(request); err != nil {
+		return nil, fmt.Errorf("failed to apply option to request: %w", err)
+	}
+
+	httpResponse, err := c.stream(request)
+	if err != nil {
+		if apiError := new(APIError); errors.As(err, &apiError) && apiError.Code == codeCameraNotFound {
+			return nil, ErrCameraNotFound
+		}
+
+		return nil, err
+	}
+
+	return httpResponse, nil
+}
+
+// cameraSegmentsReader reads the given HLS segments one after the other.
+type cameraSegmentsReader struct {
+	ctx      context.Context //nolint:containedctx
+	client   *client
+	segments []*url.URL
+	current  io.ReadCloser
+}
+
+func (r *cameraSegmentsReader) Read(p []byte) (int, error) {
+	for {
+		if r.current == nil {
+			if len(r.segments) == 0 {
+				return 0, io.EOF
+			}
+
+			httpResponse, err := r.client.openCameraResource(r.ctx, r.segments[0])
+			if err != nil {
+				return 0, fmt.Errorf("failed to GET segment %s: %w", r.segments[0], err)
+			}
+
+			r.current = httpResponse.Body
+			r.segments = r.segments[1:]
+		}
+
+		n, err := r.current.Read(p)
+		if errors.Is(err, io.EOF) {
+			closeErr := r.current.Close()
+			r.current = nil
+
+			if closeErr != nil {
+				return n, fmt.Errorf("failed to close segment: %w", closeErr)
+			}
+
+			if n == 0 {
+				continue
+			}
+
+			return n, nil
+		}
+
+		if err != nil {
+			return n, fmt.Errorf("failed to read segment: %w", err)
+		}
+
+		return n, nil
+	}
+}
+
+func (r *cameraSegmentsReader) Close() error {
+	r.segments = nil
+
+	if r.current == nil {
+		return nil
+	}
+
+	err := r.current.Close()
+	r.current = nil
+
+	return err //nolint:wrapcheck
+}
+
+func parseM3U8Playlist(reader io.Reader) (playlist types.M3U8Playlist, err error) {
+	scanner := bufio.NewScanner(reader)
+
+	if !scanner.Scan() || strings.TrimSpace(scanner.Text()) != "#EXTM3U" {
+		return playlist, errors.New("missing #EXTM3U header")
+	}
+
+	var pending *types.M3U8Segment
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#EXT-X-VERSION:"):
+			if playlist.Version, err = strconv.ParseInt(strings.TrimPrefix(line, "#EXT-X-VERSION:"), 10, 64); err != nil {
+				return playlist, fmt.Errorf("failed to parse version: %w", err)
+			}
+		case strings.HasPrefix(line, "#EXT-X-TARGETDURATION:"):
+			if playlist.TargetDuration, err = strconv.ParseInt(strings.TrimPrefix(line, "#EXT-X-TARGETDURATION:"), 10, 64); err != nil {
+				return playlist, fmt.Errorf("failed to parse target duration: %w", err)
+			}
+		case strings.HasPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"):
+			if playlist.MediaSequence, err = strconv.ParseInt(strings.TrimPrefix(line, "#EXT-X-MEDIA-SEQUENCE:"), 10, 64); err != nil {
+				return playlist, fmt.Errorf("failed to parse media sequence: %w", err)
+			}
+		case line == "#EXT-X-ENDLIST":
+			playlist.EndList = true
+		case strings.HasPrefix(line, "#EXTINF:"):
+			duration, title, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+
+			pending = &types.M3U8Segment{Title: title}
+			if pending.Duration, err = strconv.ParseFloat(duration, 64); err != nil {
+				return playlist, fmt.Errorf("failed to parse segment duration: %w", err)
+			}
+		case strings.HasPrefix(line, "#"):
+			// Unsupported tag or comment.
+			continue
+		default:
+			if pending == nil {
+				return playlist, fmt.Errorf("segment %q is not preceded by #EXTINF", line)
+			}
+
+			pending.URI = line
+			playlist.Segments = append(playlist.Segments, *pending)
+			pending = nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return playlist, fmt.Errorf("failed to read playlist: %w", err)
+	}
+
+	return playlist, nil
+}
@@ -0,0 +1,318 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("camera", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing cameras", func() {
+		returnedCameras := new([]types.Camera)
+		JustBeforeEach(func() {
+			*returnedCameras, *returnedErr = freeboxClient.ListCameras(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"node_id": 9,
+									"name": "Jardin",
+									"model": "fbx-cam",
+									"mac": "00:11:22:33:44:55",
+									"ip": "192.168.1.42",
+									"online": true,
+									"stream_url": "/api/v0/camera/1/stream.m3u8",
+									"snapshot_url": "/api/v0/camera/1/snapshot"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct cameras", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedCameras).To(HaveLen(1))
+				Expect((*returnedCameras)[0]).To(MatchFields(IgnoreExtras, Fields{
+					"ID":        Equal(int64(1)),
+					"NodeID":    Equal(int64(9)),
+					"Name":      Equal("Jardin"),
+					"Online":    Equal(true),
+					"StreamURL": Equal("/api/v0/camera/1/stream.m3u8"),
+				}))
+			})
+		})
+		Context("when there are no cameras", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedCameras).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a camera snapshot", func() {
+		returnedFile := new(types.File)
+		JustBeforeEach(func() {
+			*returnedFile, *returnedErr = freeboxClient.GetCameraSnapshot(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/snapshot", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `jpeg-bytes`, http.Header{
+							"Content-Type":        []string{"image/jpeg"},
+							"Content-Disposition": []string{`inline; filename="snapshot.jpg"`},
+						}),
+					),
+				)
+			})
+			It("should return the snapshot", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFile.ContentType).To(Equal("image/jpeg"))
+				Expect(returnedFile.FileName).To(Equal("snapshot.jpg"))
+				Expect(io.ReadAll(returnedFile.Content)).To(BeEquivalentTo([]byte("jpeg-bytes")))
+			})
+		})
+		Context("when the camera is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/snapshot", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrCameraNotFound", func() {
+				Expect(*returnedErr).To(MatchError(client.ErrCameraNotFound))
+			})
+		})
+		Context("when the server returns an unexpected payload", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/snapshot", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusBadGateway, `camera offline`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("camera offline")))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a camera stream", func() {
+		var (
+			returnedPlaylist = new(types.M3U8Playlist)
+			returnedReader   = new(io.ReadCloser)
+		)
+		JustBeforeEach(func() {
+			*returnedPlaylist, *returnedReader, *returnedErr = freeboxClient.GetCameraStream(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/stream.m3u8", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, "#EXTM3U\n"+
+							"#EXT-X-VERSION:3\n"+
+							"#EXT-X-TARGETDURATION:2\n"+
+							"#EXT-X-MEDIA-SEQUENCE:120\n"+
+							"#EXTINF:2.000,\n"+
+							"segment-120.ts\n"+
+							"#EXTINF:1.500,last\n"+
+							"/api/"+version+"/camera/1/segment-121.ts\n"+
+							"#EXT-X-ENDLIST\n",
+						),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/segment-120.ts", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `first-`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/segment-121.ts", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `second`),
+					),
+				)
+			})
+			It("should return the parsed playlist and the segments content", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPlaylist).To(Equal(types.M3U8Playlist{
+					Version:        3,
+					TargetDuration: 2,
+					MediaSequence:  120,
+					EndList:        true,
+					Segments: []types.M3U8Segment{
+						{Duration: 2, URI: "segment-120.ts"},
+						{Duration: 1.5, Title: "last", URI: "/api/" + version + "/camera/1/segment-121.ts"},
+					},
+				}))
+				Expect(io.ReadAll(*returnedReader)).To(BeEquivalentTo([]byte("first-second")))
+				Expect((*returnedReader).Close()).To(Succeed())
+			})
+		})
+		Context("when a segment cannot be retrieved", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/stream.m3u8", version)),
+						ghttp.RespondWith(http.StatusOK, "#EXTM3U\n#EXTINF:2,\nsegment-1.ts\n"),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/segment-1.ts", version)),
+						ghttp.RespondWith(http.StatusNotFound, `gone`),
+					),
+				)
+			})
+			It("should return an error when reading", func() {
+				Expect(*returnedErr).To(BeNil())
+				_, err := io.ReadAll(*returnedReader)
+				Expect(err).To(MatchError(ContainSubstring("gone")))
+				Expect((*returnedReader).Close()).To(Succeed())
+			})
+		})
+		Context("when a segment is served by another host", func() {
+			foreign := new(ghttp.Server)
+			BeforeEach(func() {
+				foreign = ghttp.NewServer()
+				DeferCleanup(foreign.Close)
+
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/stream.m3u8", version)),
+						ghttp.RespondWith(http.StatusOK, "#EXTM3U\n#EXTINF:2,\n"+foreign.URL()+"/segment-1.ts\n"),
+					),
+				)
+			})
+			It("should return an error without requesting the segment", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("is not served by the freebox")))
+				Expect(*returnedReader).To(BeNil())
+				Expect(foreign.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+		Context("when the playlist is invalid", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/stream.m3u8", version)),
+						ghttp.RespondWith(http.StatusOK, "not a playlist"),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("#EXTM3U")))
+				Expect(*returnedReader).To(BeNil())
+			})
+		})
+		Context("when a segment duration is invalid", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/stream.m3u8", version)),
+						ghttp.RespondWith(http.StatusOK, "#EXTM3U\n#EXTINF:abc,\nsegment-1.ts\n"),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+		Context("when the camera is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/camera/1/stream.m3u8", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
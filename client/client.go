@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"regexp"
+	"sync"
 	"time"
 
 	"github.com/nikolalohinski/free-go/types"
@@ -18,32 +19,68 @@ type Client interface {
 	WithAppID(string) Client
 	WithPrivateToken(types.PrivateToken) Client
 	WithHTTPClient(HTTPClient) Client
+	WithRetryPolicy(RetryPolicy) Client
+	WithRateLimit(rps float64, burst int) Client
+	WithMaxConcurrentRequests(int) Client
+	WithMiddlewares(...Middleware) Client
 	// unauthenticated
 	APIVersion(context.Context) (types.APIVersion, error)
 	// authentication
 	Authorize(context.Context, types.AuthorizationRequest) (types.PrivateToken, error)
 	Login(context.Context) (types.Permissions, error)
 	Logout(context.Context) error
+	// connection
+	GetConnectionStatus(ctx context.Context) (types.ConnectionStatus, error)
+	GetConnectionConfiguration(ctx context.Context) (types.ConnectionConfiguration, error)
+	UpdateConnectionConfiguration(ctx context.Context, payload types.ConnectionConfiguration) (types.ConnectionConfiguration, error)
+	GetIPv6Configuration(ctx context.Context) (types.IPv6Configuration, error)
+	UpdateIPv6Configuration(ctx context.Context, payload types.IPv6Configuration) (types.IPv6Configuration, error)
+	GetDynDNSStatus(ctx context.Context, provider types.DynDNSProvider) (types.DynDNSStatus, error)
+	GetDynDNSConfiguration(ctx context.Context, provider types.DynDNSProvider) (types.DynDNSConfiguration, error)
+	UpdateDynDNSConfiguration(ctx context.Context, provider types.DynDNSProvider, payload types.DynDNSConfiguration) (types.DynDNSConfiguration, error)
 	// port forwarding
 	ListPortForwardingRules(context.Context) ([]types.PortForwardingRule, error)
 	GetPortForwardingRule(ctx context.Context, identifier int64) (types.PortForwardingRule, error)
 	CreatePortForwardingRule(ctx context.Context, payload types.PortForwardingRulePayload) (types.PortForwardingRule, error)
 	UpdatePortForwardingRule(ctx context.Context, identifier int64, payload types.PortForwardingRulePayload) (types.PortForwardingRule, error)
 	DeletePortForwardingRule(ctx context.Context, identifier int64) error
+	// incoming ports
+	ListIncomingPorts(ctx context.Context) ([]types.IncomingPort, error)
+	GetIncomingPort(ctx context.Context, identifier types.IncomingPortID) (types.IncomingPort, error)
+	UpdateIncomingPort(ctx context.Context, identifier types.IncomingPortID, payload types.IncomingPortPayload) (types.IncomingPort, error)
 	// dhcp
+	GetDHCPConfiguration(ctx context.Context) (types.DHCPConfiguration, error)
+	UpdateDHCPConfiguration(ctx context.Context, payload types.DHCPConfiguration) (types.DHCPConfiguration, error)
+	GetDHCPv6Configuration(ctx context.Context) (types.DHCPv6Configuration, error)
+	UpdateDHCPv6Configuration(ctx context.Context, payload types.DHCPv6Configuration) (types.DHCPv6Configuration, error)
+	ListDHCPDynamicLeases(ctx context.Context) ([]types.DHCPDynamicLease, error)
 	ListDHCPStaticLease(context.Context) ([]types.DHCPStaticLeaseInfo, error)
 	GetDHCPStaticLease(ctx context.Context, identifier string) (types.DHCPStaticLeaseInfo, error)
 	UpdateDHCPStaticLease(ctx context.Context, identifier string, payload types.DHCPStaticLeasePayload) (types.LanInterfaceHost, error)
 	CreateDHCPStaticLease(ctx context.Context, payload types.DHCPStaticLeasePayload) (types.LanInterfaceHost, error)
 	DeleteDHCPStaticLease(ctx context.Context, identifier string) error
+	PinHost(ctx context.Context, mac, ip string) (types.LanInterfaceHost, error)
 	// lan
 	GetLanConfig(ctx context.Context) (result types.LanConfig, err error)
 	UpdateLanConfig(ctx context.Context, payload types.LanConfig) (result types.LanConfig, err error)
+	// freeplug
+	ListFreeplugNetworks(ctx context.Context) ([]types.FreeplugNetwork, error)
+	GetFreeplug(ctx context.Context, identifier string) (types.Freeplug, error)
+	ResetFreeplug(ctx context.Context, identifier string) error
+	GetFreeplugGraph(ctx context.Context) (types.FreeplugGraph, error)
+	// switch
+	GetSwitchStatus(ctx context.Context) ([]types.SwitchPortStatus, error)
+	GetSwitchPortConfiguration(ctx context.Context, identifier int64) (types.SwitchPortConfiguration, error)
+	UpdateSwitchPortConfiguration(ctx context.Context, identifier int64, payload types.SwitchPortConfigurationPayload) (types.SwitchPortConfiguration, error)
+	ListSwitchPortMacs(ctx context.Context, identifier int64) ([]types.SwitchPortMac, error)
+	GetSwitchPortStats(ctx context.Context, identifier int64) (types.SwitchPortStats, error)
 	// lan browser
 	ListLanInterfaceInfo(context.Context) ([]types.LanInfo, error)
 	GetLanInterface(ctx context.Context, name string) (result []types.LanInterfaceHost, err error)
 	GetLanInterfaceHost(ctx context.Context, interfaceName, identifier string) (result types.LanInterfaceHost, err error)
 	DeleteLanInterfaceHost(ctx context.Context, interfaceName, identifier string) error
+	UpdateLanInterfaceHost(ctx context.Context, interfaceName, identifier string, payload types.LanInterfaceHostPayload) (types.LanInterfaceHost, error)
+	WakeOnLAN(ctx context.Context, interfaceName, mac, password string) error
 	// virtual machines
 	GetVirtualMachineInfo(context.Context) (result types.VirtualMachinesInfo, err error)
 	GetVirtualMachineDistributions(context.Context) (result []types.VirtualMachineDistribution, err error)
@@ -78,8 +115,21 @@ type Client interface {
 	MoveFiles(ctx context.Context, sources []string, destination string, mode types.FileMoveMode) (result types.FileSystemTask, err error)
 	CopyFiles(ctx context.Context, sources []string, destination string, mode types.FileCopyMode) (result types.FileSystemTask, err error)
 	ExtractFile(ctx context.Context, payload types.ExtractFilePayload) (task types.FileSystemTask, err error)
+	ConcatenateFiles(ctx context.Context, payload types.ConcatenateFilesPayload) (task types.FileSystemTask, err error)
+	CreateArchive(ctx context.Context, payload types.CreateArchivePayload) (task types.FileSystemTask, err error)
+	RepairFile(ctx context.Context, path string) (task types.FileSystemTask, err error)
+	RenameFile(ctx context.Context, path, name string) (task types.FileSystemTask, err error)
+	// share links
+	ListShareLinks(ctx context.Context) ([]types.ShareLink, error)
+	GetShareLink(ctx context.Context, token string) (types.ShareLink, error)
+	CreateShareLink(ctx context.Context, payload types.ShareLinkPayload) (types.ShareLink, error)
+	DeleteShareLink(ctx context.Context, token string) error
+	PruneExpiredShareLinks(ctx context.Context) ([]types.ShareLink, error)
 	// system
 	GetSystemInfo(ctx context.Context) (types.SystemConfig, error)
+	Reboot(ctx context.Context) error
+	RebootAndWait(ctx context.Context, timeout time.Duration) (uptime int64, err error)
+	Shutdown(ctx context.Context) error
 	// downloads
 	ListDownloadTasks(ctx context.Context) ([]types.DownloadTask, error)
 	GetDownloadTask(ctx context.Context, identifier int64) (types.DownloadTask, error)
@@ -87,6 +137,17 @@ type Client interface {
 	DeleteDownloadTask(ctx context.Context, identifier int64) error
 	EraseDownloadTask(ctx context.Context, identifier int64) error
 	UpdateDownloadTask(ctx context.Context, identifier int64, payload types.DownloadTaskUpdate) error
+	GetDownloadLog(ctx context.Context, identifier int64) (string, error)
+	ListDownloadFiles(ctx context.Context, identifier int64) ([]types.DownloadFile, error)
+	UpdateDownloadFile(ctx context.Context, identifier int64, fileID string, payload types.DownloadFileUpdate) error
+	ListDownloadTrackers(ctx context.Context, identifier int64) ([]types.DownloadTracker, error)
+	AddDownloadTracker(ctx context.Context, identifier int64, announce string) error
+	UpdateDownloadTracker(ctx context.Context, identifier int64, announce string, payload types.DownloadTrackerUpdate) error
+	RemoveDownloadTracker(ctx context.Context, identifier int64, announce string) error
+	ListDownloadPeers(ctx context.Context, identifier int64) ([]types.DownloadPeer, error)
+	GetDownloadPieces(ctx context.Context, identifier int64) (types.DownloadPieces, error)
+	ListDownloadBlacklist(ctx context.Context, identifier int64) ([]types.DownloadBlacklistEntry, error)
+	EmptyDownloadBlacklist(ctx context.Context, identifier int64) error
 	GetDownloadConfiguration(ctx context.Context) (types.DownloadConfiguration, error)
 	UpdateDownloadConfiguration(ctx context.Context, payload types.DownloadConfiguration) (types.DownloadConfiguration, error)
 	// uploads
@@ -110,12 +171,123 @@ type Client interface {
 	UpdateSambaConfiguration(ctx context.Context, payload types.SambaConfigurationPayload) (types.SambaConfiguration, error)
 	GetAFPConfiguration(ctx context.Context) (types.AFPConfiguration, error)
 	UpdateAFPConfiguration(ctx context.Context, payload types.AFPConfigurationPayload) (types.AFPConfiguration, error)
+	// lcd
+	GetLCDConfiguration(ctx context.Context) (types.LCDConfiguration, error)
+	UpdateLCDConfiguration(ctx context.Context, payload types.LCDConfiguration) (types.LCDConfiguration, error)
+	// upnp av
+	GetUPnPAVConfiguration(ctx context.Context) (types.UPnPAVConfiguration, error)
+	UpdateUPnPAVConfiguration(ctx context.Context, payload types.UPnPAVConfiguration) (types.UPnPAVConfiguration, error)
+	// airmedia
+	GetAirMediaConfiguration(ctx context.Context) (types.AirMediaConfiguration, error)
+	UpdateAirMediaConfiguration(ctx context.Context, payload types.AirMediaConfiguration) (types.AirMediaConfiguration, error)
+	ListAirMediaReceivers(ctx context.Context) ([]types.AirMediaReceiver, error)
+	SendAirMediaRequest(ctx context.Context, receiver string, payload types.AirMediaReceiverRequest) error
 	// network control
 	ListNetworkControl(ctx context.Context) ([]types.NetworkControlInfo, error)
 	GetNetworkControl(ctx context.Context, identifier int64) (types.NetworkControlInfo, error)
 	UpdateNetworkControl(ctx context.Context, payload types.NetworkControlPayload) (types.NetworkControlInfo, error)
+	CreateNetworkControl(ctx context.Context, payload types.NetworkControlPayload) (types.NetworkControlInfo, error)
+	PauseProfile(ctx context.Context, profileID types.ProfileID, until time.Time) (types.NetworkControlInfo, error)
+	ListNetworkControlRules(ctx context.Context, profileID types.ProfileID) ([]types.NetworkControlRule, error)
+	GetNetworkControlRule(ctx context.Context, profileID types.ProfileID, identifier int64) (types.NetworkControlRule, error)
+	CreateNetworkControlRule(ctx context.Context, profileID types.ProfileID, payload types.NetworkControlRulePayload) (types.NetworkControlRule, error)
+	UpdateNetworkControlRule(ctx context.Context, profileID types.ProfileID, identifier int64, payload types.NetworkControlRulePayload) (types.NetworkControlRule, error)
+	DeleteNetworkControlRule(ctx context.Context, profileID types.ProfileID, identifier int64) error
 	// profile
 	ListProfiles(context.Context) ([]types.Profile, error)
+	CreateProfile(ctx context.Context, payload types.ProfilePayload) (types.Profile, error)
+	UpdateProfile(ctx context.Context, identifier types.ProfileID, payload types.ProfilePayload) (types.Profile, error)
+	DeleteProfile(ctx context.Context, identifier types.ProfileID) error
+	// wifi
+	GetWifiGlobalConfig(ctx context.Context) (types.WifiGlobalConfig, error)
+	UpdateWifiGlobalConfig(ctx context.Context, payload types.WifiGlobalConfig) (types.WifiGlobalConfig, error)
+	ResetWifiConfig(ctx context.Context) error
+	ListWifiAccessPoints(ctx context.Context) ([]types.WifiAccessPoint, error)
+	GetWifiAccessPoint(ctx context.Context, identifier int64) (types.WifiAccessPoint, error)
+	UpdateWifiAccessPoint(ctx context.Context, identifier int64, config types.WifiAccessPointConfig) (types.WifiAccessPoint, error)
+	ListWifiAllowedChannelCombinations(ctx context.Context, identifier int64) ([]types.WifiAllowedChannelCombination, error)
+	ListWifiStations(ctx context.Context, identifier int64) ([]types.WifiStation, error)
+	ListWifiNeighbors(ctx context.Context, identifier int64) ([]types.WifiNeighbor, error)
+	ListWifiChannelUsage(ctx context.Context, identifier int64) ([]types.WifiChannelUsage, error)
+	RefreshWifiRadar(ctx context.Context, identifier int64) error
+	ListWifiBSS(ctx context.Context) ([]types.WifiBSS, error)
+	GetWifiBSS(ctx context.Context, identifier string) (types.WifiBSS, error)
+	UpdateWifiBSS(ctx context.Context, identifier string, config types.WifiBSSConfig) (types.WifiBSS, error)
+	GetWifiPlanning(ctx context.Context) (types.WifiPlanning, error)
+	UpdateWifiPlanning(ctx context.Context, payload types.WifiPlanning) (types.WifiPlanning, error)
+	ListWifiMacFilters(ctx context.Context) ([]types.WifiMacFilter, error)
+	GetWifiMacFilter(ctx context.Context, identifier string) (types.WifiMacFilter, error)
+	CreateWifiMacFilter(ctx context.Context, payload types.WifiMacFilterPayload) (types.WifiMacFilter, error)
+	UpdateWifiMacFilter(ctx context.Context, identifier string, payload types.WifiMacFilterPayload) (types.WifiMacFilter, error)
+	DeleteWifiMacFilter(ctx context.Context, identifier string) error
+	// home
+	ListHomeNodes(ctx context.Context) ([]types.HomeNode, error)
+	GetHomeNode(ctx context.Context, identifier int64) (types.HomeNode, error)
+	UpdateHomeNode(ctx context.Context, identifier int64, payload types.HomeNodePayload) (types.HomeNode, error)
+	DeleteHomeNode(ctx context.Context, identifier int64) error
+	ListHomeAdapters(ctx context.Context) ([]types.HomeAdapter, error)
+	GetHomeAdapter(ctx context.Context, identifier int64) (types.HomeAdapter, error)
+	GetHomeEndpointValue(ctx context.Context, nodeID, endpointID int64) (types.HomeEndpointValue, error)
+	SetHomeEndpointValue(ctx context.Context, nodeID, endpointID int64, payload types.HomeEndpointValuePayload) error
+	GetHomeEndpointValues(ctx context.Context, endpoints []types.HomeEndpointReference) ([]types.HomeEndpointValue, error)
+	ListHomeLinks(ctx context.Context) ([]types.HomeLink, error)
+	GetHomeLink(ctx context.Context, identifier int64) (types.HomeLink, error)
+	GetHomeSecurityModule(ctx context.Context) (types.HomeSecurityModule, error)
+	// home pairing
+	GetHomePairingState(ctx context.Context, adapterID int64) (types.HomePairingState, error)
+	StartHomePairing(ctx context.Context, adapterID int64) error
+	AdvanceHomePairing(ctx context.Context, adapterID int64, payload types.HomePairingAdvance) error
+	StopHomePairing(ctx context.Context, adapterID int64) error
+	PairHomeDevice(ctx context.Context, adapterID int64, options types.HomePairingOptions) (chan types.HomePairingEvent, error)
+	// camera
+	ListCameras(ctx context.Context) ([]types.Camera, error)
+	GetCameraSnapshot(ctx context.Context, identifier int64) (types.File, error)
+	GetCameraStream(ctx context.Context, identifier int64) (types.M3U8Playlist, io.ReadCloser, error)
+	// call
+	ListCallEntries(ctx context.Context) ([]types.CallEntry, error)
+	GetCallEntry(ctx context.Context, identifier int64) (types.CallEntry, error)
+	UpdateCallEntry(ctx context.Context, identifier int64, payload types.CallEntryPayload) (types.CallEntry, error)
+	DeleteCallEntry(ctx context.Context, identifier int64) error
+	DeleteAllCallEntries(ctx context.Context) error
+	MarkAllCallEntriesAsRead(ctx context.Context) error
+	// contact
+	ListContacts(ctx context.Context) ([]types.Contact, error)
+	GetContact(ctx context.Context, identifier int64) (types.Contact, error)
+	CreateContact(ctx context.Context, payload types.ContactPayload) (types.Contact, error)
+	UpdateContact(ctx context.Context, identifier int64, payload types.ContactPayload) (types.Contact, error)
+	DeleteContact(ctx context.Context, identifier int64) error
+	ListContactNumbers(ctx context.Context, contactID int64) ([]types.ContactNumber, error)
+	GetContactNumber(ctx context.Context, identifier int64) (types.ContactNumber, error)
+	CreateContactNumber(ctx context.Context, payload types.ContactNumberPayload) (types.ContactNumber, error)
+	UpdateContactNumber(ctx context.Context, identifier int64, payload types.ContactNumberPayload) (types.ContactNumber, error)
+	DeleteContactNumber(ctx context.Context, identifier int64) error
+	ListContactAddresses(ctx context.Context, contactID int64) ([]types.ContactAddress, error)
+	GetContactAddress(ctx context.Context, identifier int64) (types.ContactAddress, error)
+	CreateContactAddress(ctx context.Context, payload types.ContactAddressPayload) (types.ContactAddress, error)
+	UpdateContactAddress(ctx context.Context, identifier int64, payload types.ContactAddressPayload) (types.ContactAddress, error)
+	DeleteContactAddress(ctx context.Context, identifier int64) error
+	ListContactEmails(ctx context.Context, contactID int64) ([]types.ContactEmail, error)
+	GetContactEmail(ctx context.Context, identifier int64) (types.ContactEmail, error)
+	CreateContactEmail(ctx context.Context, payload types.ContactEmailPayload) (types.ContactEmail, error)
+	UpdateContactEmail(ctx context.Context, identifier int64, payload types.ContactEmailPayload) (types.ContactEmail, error)
+	DeleteContactEmail(ctx context.Context, identifier int64) error
+	ListContactURLs(ctx context.Context, contactID int64) ([]types.ContactURL, error)
+	GetContactURL(ctx context.Context, identifier int64) (types.ContactURL, error)
+	CreateContactURL(ctx context.Context, payload types.ContactURLPayload) (types.ContactURL, error)
+	UpdateContactURL(ctx context.Context, identifier int64, payload types.ContactURLPayload) (types.ContactURL, error)
+	DeleteContactURL(ctx context.Context, identifier int64) error
+	ExportContactsVCard(ctx context.Context, writer io.Writer) error
+	ImportContactsVCard(ctx context.Context, reader io.Reader) ([]types.Contact, error)
+	// parental
+	GetParentalConfig(ctx context.Context) (types.ParentalConfig, error)
+	UpdateParentalConfig(ctx context.Context, payload types.ParentalConfig) (types.ParentalConfig, error)
+	ListParentalFilters(ctx context.Context) ([]types.ParentalFilter, error)
+	GetParentalFilter(ctx context.Context, identifier int64) (types.ParentalFilter, error)
+	CreateParentalFilter(ctx context.Context, payload types.ParentalFilterPayload) (types.ParentalFilter, error)
+	UpdateParentalFilter(ctx context.Context, identifier int64, payload types.ParentalFilterPayload) (types.ParentalFilter, error)
+	DeleteParentalFilter(ctx context.Context, identifier int64) error
+	GetParentalFilterPlanning(ctx context.Context, identifier int64) (types.ParentalFilterPlanning, error)
+	UpdateParentalFilterPlanning(ctx context.Context, identifier int64, payload types.ParentalFilterPlanning) (types.ParentalFilterPlanning, error)
 }
 
 type HTTPClient interface {
@@ -144,9 +316,17 @@ type client struct {
 	httpClient   HTTPClient
 	privateToken *string
 	appID        *string
+	retryPolicy  RetryPolicy
+	rateLimiter  *rateLimiter
+	requestSlots chan struct{} // bounds the number of requests in flight
+	middlewares  []Middleware
 
-	session *session
-	base    *url.URL
+	// sessionMutex guards session and login, so that the client can be shared by concurrent requests.
+	sessionMutex sync.Mutex
+	session      *session
+	login        *loginCall // login in flight, shared by every request waiting for a session
+
+	base *url.URL
 }
 
 type session struct {
@@ -154,6 +334,14 @@ type session struct {
 	expires time.Time
 }
 
+type loginCall struct {
+	done chan struct{} // closed once the login is over
+
+	session     *session
+	permissions types.Permissions
+	err         error
+}
+
 func (c *client) WithAppID(appID string) Client {
 	c.appID = &appID
 
@@ -171,3 +359,33 @@ func (c *client) WithHTTPClient(httpClient HTTPClient) Client {
 
 	return c
 }
+
+func (c *client) WithRetryPolicy(policy RetryPolicy) Client {
+	c.retryPolicy = policy
+
+	return c
+}
+
+func (c *client) WithRateLimit(rps float64, burst int) Client {
+	c.rateLimiter = nil
+	if rps > 0 {
+		c.rateLimiter = newRateLimiter(rps, burst)
+	}
+
+	return c
+}
+
+func (c *client) WithMaxConcurrentRequests(maxRequests int) Client {
+	c.requestSlots = nil
+	if maxRequests > 0 {
+		c.requestSlots = make(chan struct{}, maxRequests)
+	}
+
+	return c
+}
+
+func (c *client) WithMiddlewares(middlewares ...Middleware) Client {
+	c.middlewares = append(c.middlewares, middlewares...)
+
+	return c
+}
@@ -4,11 +4,11 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"reflect"
-	"time"
 )
 
 const (
@@ -51,12 +51,34 @@ func (c *client) getRaw(ctx context.Context, path string, options ...HTTPOption)
 		}
 	}
 
-	httpResponse, err := c.httpClient.Do(request)
+	var body []byte
+
+	err = c.invoke(&Call{Operation: operationName(request), Request: request}, func(call *Call) (err error) {
+		body, err = c.sendRaw(call)
+
+		return err
+	})
+
+	return body, err
+}
+
+func (c *client) sendRaw(call *Call) (_ []byte, err error) {
+	release, err := c.acquire(call.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	defer func() { c.observeRateLimit(err) }()
+
+	httpResponse, err := c.httpClient.Do(call.Request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform request: %w", err)
 	}
 	defer httpResponse.Body.Close()
 
+	call.Response = httpResponse
+
 	body, err := io.ReadAll(httpResponse.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %w", err)
@@ -76,6 +98,61 @@ func (c *client) getRaw(ctx context.Context, path string, options ...HTTPOption)
 	return body, nil
 }
 
+// stream performs a request against an endpoint that returns a raw body, such as a file download or a camera
+// stream, and returns the response for the caller to stream its body, which it then owns. Any status other than
+// 200 is returned as an error, wrapping *APIError when the Freebox returned one.
+func (c *client) stream(request *http.Request) (*http.Response, error) {
+	var httpResponse *http.Response
+
+	err := c.invoke(&Call{Operation: operationName(request), Request: request}, func(call *Call) (err error) {
+		httpResponse, err = c.sendStream(call)
+
+		return err
+	})
+
+	return httpResponse, err
+}
+
+// sendStream only holds its request slot until the response headers are received: the body of a download may
+// never be closed by the caller, which would otherwise leak the slot.
+func (c *client) sendStream(call *Call) (_ *http.Response, err error) {
+	release, err := c.acquire(call.Request.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	defer func() { c.observeRateLimit(err) }()
+
+	httpResponse, err := c.httpClient.Do(call.Request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to perform request: %w", err)
+	}
+
+	call.Response = httpResponse
+
+	if httpResponse.StatusCode == http.StatusOK {
+		return httpResponse, nil
+	}
+
+	defer httpResponse.Body.Close()
+
+	body, err := io.ReadAll(httpResponse.Body)
+	if err != nil {
+		return nil, errors.Join(
+			fmt.Errorf("failed with status '%d'", httpResponse.StatusCode),
+			fmt.Errorf("failed to read response body: %w", err),
+		)
+	}
+
+	generic := new(genericResponse)
+	if json.Unmarshal(body, generic) == nil && !generic.Success && generic.ErrorCode != "" {
+		return nil, &APIError{Code: generic.ErrorCode, Message: generic.Message}
+	}
+
+	return nil, fmt.Errorf("failed with status '%d': server returned '%s'", httpResponse.StatusCode, string(body))
+}
+
 func (c *client) delete(ctx context.Context, path string, options ...HTTPOption) (response *genericResponse, err error) {
 	request, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/%s", c.base, path), nil)
 	if err != nil {
@@ -121,18 +198,80 @@ func (c *client) post(ctx context.Context, path string, body interface{}, option
 	return c.do(request, options...)
 }
 
-func (c *client) do(request *http.Request, options ...HTTPOption) (response *genericResponse, err error) {
+func (c *client) do(request *http.Request, options ...HTTPOption) (*genericResponse, error) {
 	for _, option := range options {
 		if err := option(request); err != nil {
 			return nil, fmt.Errorf("failed to apply option to request: %w", err)
 		}
 	}
 
+	var response *genericResponse
+
+	err := c.invoke(&Call{Operation: operationName(request), Request: request}, func(call *Call) (err error) {
+		response, err = c.sendWithRenewal(call)
+
+		return err
+	})
+
+	return response, err
+}
+
+func (c *client) sendWithRenewal(call *Call) (*genericResponse, error) {
+	request := call.Request
+
+	response, err := c.sendWithRetries(call, request)
+
+	// The box may invalidate a session before it expires locally, for instance when it reboots.
+	// In that case, log in again and replay the request once.
+	token := request.Header.Get(AuthHeader)
+	if token == "" || !isSessionError(err) {
+		return response, err
+	}
+
+	replay, replayErr := c.withRenewedSession(request, token)
+	if replayErr != nil {
+		return response, errors.Join(err, replayErr)
+	}
+
+	return c.sendWithRetries(call, replay)
+}
+
+// withRenewedSession returns a copy of the request with its body rewound and a valid session
+// in place of the given stale token.
+func (c *client) withRenewedSession(request *http.Request, staleToken string) (*http.Request, error) {
+	replay, err := rewind(request)
+	if err != nil {
+		return nil, err
+	}
+
+	renewed, err := c.renewSession(request.Context(), staleToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to login again after session was invalidated: %w", err)
+	}
+
+	replay.Header.Set(AuthHeader, renewed.token)
+
+	return replay, nil
+}
+
+func (c *client) send(call *Call, request *http.Request) (response *genericResponse, err error) {
+	call.Response = nil
+
+	release, err := c.acquire(request.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	defer func() { c.observeRateLimit(err) }()
+
 	httpResponse, err := c.httpClient.Do(request)
 	if err != nil {
 		return nil, fmt.Errorf("failed to perform request: %w", err)
 	}
 
+	call.Response = httpResponse
+
 	defer func() {
 		closeError := httpResponse.Body.Close()
 		if err == nil {
@@ -163,6 +302,16 @@ func (c *client) fromGenericResponse(generic *genericResponse, target interface{
 	return nil
 }
 
+// isSessionError reports whether the error means the session used by the request is no longer valid.
+func isSessionError(err error) bool {
+	apiError := new(APIError)
+	if !errors.As(err, &apiError) {
+		return false
+	}
+
+	return apiError.Code == codeAuthRequired || apiError.Code == codeInvalidSession
+}
+
 func (c *client) fromHTTPResponse(httpResponse *http.Response) (*genericResponse, error) {
 	body, err := io.ReadAll(httpResponse.Body)
 	if err != nil {
@@ -170,7 +319,7 @@ func (c *client) fromHTTPResponse(httpResponse *http.Response) (*genericResponse
 	}
 
 	if httpResponse.StatusCode >= http.StatusInternalServerError {
-		return nil, fmt.Errorf("failed with status '%d': server returned '%s'", httpResponse.StatusCode, string(body))
+		return nil, &StatusError{StatusCode: httpResponse.StatusCode, Body: string(body)}
 	}
 
 	response := new(genericResponse)
@@ -202,19 +351,21 @@ func (c *client) withWWWFormURLEncodedContentType(req *http.Request) error {
 
 func (c *client) withSession(ctx context.Context) func(req *http.Request) error {
 	return func(req *http.Request) error {
-		if c.session == nil {
-			if _, err := c.Login(ctx); err != nil {
+		current, expired := c.currentSession()
+		if current == nil {
+			call, err := c.refreshSession(ctx)
+			if err != nil {
+				if expired {
+					return fmt.Errorf("failed to login again after session expired: %w", err)
+				}
+
 				return fmt.Errorf("failed to login before attempting request: %w", err)
 			}
-		}
 
-		if time.Now().After(c.session.expires) {
-			if _, err := c.Login(ctx); err != nil {
-				return fmt.Errorf("failed to login again after session expired: %w", err)
-			}
+			current = call.session
 		}
 
-		req.Header.Add(AuthHeader, c.session.token)
+		req.Header.Add(AuthHeader, current.token)
 
 		return nil
 	}
@@ -239,3 +390,13 @@ func (e *APIError) Is(target error) bool {
 
 	return ok && t.Code == e.Code
 }
+
+// StatusError is returned when the box answers with a server error instead of the usual JSON envelope.
+type StatusError struct {
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("failed with status '%d': server returned '%s'", e.StatusCode, e.Body)
+}
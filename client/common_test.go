@@ -1,12 +1,17 @@
 package client_test
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/http"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
 
 	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
 )
 
 var _ = Describe("APIError", func() {
@@ -97,3 +102,163 @@ var _ = Describe("APIError", func() {
 		})
 	})
 })
+
+var _ = Describe("session renewal", func() {
+	const renewedToken = "renewed-session-token"
+
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("when a GET request is rejected because of the session", func() {
+		returnedConfig := new(types.UPnPAVConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+		})
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "auth_required", "msg": "Invalid session token, or no session token sent"}`),
+				),
+			)
+			setupLoginFlowWithToken(server, renewedToken)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+						verifyAuth(renewedToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`),
+					),
+				)
+			})
+			It("should login again and replay the request", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.UPnPAVConfiguration{Enabled: true}))
+				Expect(server.ReceivedRequests()).To(HaveLen(6))
+			})
+			It("should keep the new session for the next requests", func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+						verifyAuth(renewedToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": false}}`),
+					),
+				)
+				_, err := freeboxClient.GetUPnPAVConfiguration(ctx)
+				Expect(err).To(BeNil())
+				Expect(server.ReceivedRequests()).To(HaveLen(7))
+			})
+		})
+		Context("when the replayed request is rejected too", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+						verifyAuth(renewedToken),
+						ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "auth_required"}`),
+					),
+				)
+			})
+			It("should return the error without replaying the request again", func() {
+				Expect(*returnedErr).To(MatchError(&client.APIError{Code: "auth_required"}))
+				Expect(server.ReceivedRequests()).To(HaveLen(6))
+			})
+		})
+	})
+
+	Context("when a PUT request is rejected because of the session", func() {
+		JustBeforeEach(func() {
+			_, *returnedErr = freeboxClient.UpdateUPnPAVConfiguration(ctx, types.UPnPAVConfiguration{Enabled: true})
+		})
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"enabled": true}`),
+					ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "invalid_session"}`),
+				),
+			)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				setupLoginFlowWithToken(server, renewedToken)
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+						verifyAuth(renewedToken),
+						ghttp.VerifyContentType("application/json"),
+						ghttp.VerifyJSON(`{"enabled": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`),
+					),
+				)
+			})
+			It("should replay the request with the same body", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(server.ReceivedRequests()).To(HaveLen(6))
+			})
+		})
+		Context("when logging in again fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/login", version)),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+			It("should return both errors", func() {
+				Expect(*returnedErr).To(MatchError(&client.APIError{Code: "invalid_session"}))
+				Expect((*returnedErr).Error()).To(ContainSubstring("failed to login again after session was invalidated"))
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+			})
+		})
+	})
+
+	Context("when a request is rejected for another reason", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "insufficient_rights"}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+		})
+		It("should not login again", func() {
+			Expect(*returnedErr).To(MatchError(&client.APIError{Code: "insufficient_rights"}))
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+	})
+})
@@ -0,0 +1,136 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+// GetConnectionStatus returns the current status of the WAN connection.
+func (c *client) GetConnectionStatus(ctx context.Context) (status types.ConnectionStatus, err error) {
+	response, err := c.get(ctx, "connection/", c.withSession(ctx))
+	if err != nil {
+		return status, fmt.Errorf("failed to GET connection/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &status); err != nil {
+		return status, fmt.Errorf("failed to get connection status from generic response: %w", err)
+	}
+
+	return status, nil
+}
+
+// GetConnectionConfiguration returns the current configuration of the WAN connection.
+func (c *client) GetConnectionConfiguration(ctx context.Context) (config types.ConnectionConfiguration, err error) {
+	response, err := c.get(ctx, "connection/config/", c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to GET connection/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get connection configuration from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// UpdateConnectionConfiguration updates the configuration of the WAN connection.
+func (c *client) UpdateConnectionConfiguration(
+	ctx context.Context,
+	payload types.ConnectionConfiguration,
+) (config types.ConnectionConfiguration, err error) {
+	response, err := c.put(ctx, "connection/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to PUT connection/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get updated connection configuration from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// GetIPv6Configuration returns the current IPv6 configuration of the WAN connection.
+func (c *client) GetIPv6Configuration(ctx context.Context) (config types.IPv6Configuration, err error) {
+	response, err := c.get(ctx, "connection/ipv6/config/", c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to GET connection/ipv6/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get IPv6 configuration from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// UpdateIPv6Configuration updates the IPv6 configuration of the WAN connection.
+func (c *client) UpdateIPv6Configuration(
+	ctx context.Context,
+	payload types.IPv6Configuration,
+) (config types.IPv6Configuration, err error) {
+	response, err := c.put(ctx, "connection/ipv6/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to PUT connection/ipv6/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get updated IPv6 configuration from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// GetDynDNSStatus returns the status of the given DynDNS service.
+func (c *client) GetDynDNSStatus(ctx context.Context, provider types.DynDNSProvider) (status types.DynDNSStatus, err error) {
+	endpoint := fmt.Sprintf("connection/ddns/%s/status/", provider)
+
+	response, err := c.get(ctx, endpoint, c.withSession(ctx))
+	if err != nil {
+		return status, fmt.Errorf("failed to GET %s endpoint: %w", endpoint, err)
+	}
+
+	if err = c.fromGenericResponse(response, &status); err != nil {
+		return status, fmt.Errorf("failed to get DynDNS status from generic response: %w", err)
+	}
+
+	return status, nil
+}
+
+// GetDynDNSConfiguration returns the configuration of the given DynDNS service.
+func (c *client) GetDynDNSConfiguration(ctx context.Context, provider types.DynDNSProvider) (config types.DynDNSConfiguration, err error) {
+	endpoint := fmt.Sprintf("connection/ddns/%s/", provider)
+
+	response, err := c.get(ctx, endpoint, c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to GET %s endpoint: %w", endpoint, err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get DynDNS configuration from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// UpdateDynDNSConfiguration updates the configuration of the given DynDNS service.
+func (c *client) UpdateDynDNSConfiguration(
+	ctx context.Context,
+	provider types.DynDNSProvider,
+	payload types.DynDNSConfiguration,
+) (config types.DynDNSConfiguration, err error) {
+	endpoint := fmt.Sprintf("connection/ddns/%s/", provider)
+
+	response, err := c.put(ctx, endpoint, payload, c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to PUT %s endpoint: %w", endpoint, err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get updated DynDNS configuration from generic response: %w", err)
+	}
+
+	return config, nil
+}
@@ -0,0 +1,502 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("connection", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	// ── Connection status ───────────────────────────────────────────────────────
+
+	Context("getting the connection status", func() {
+		returnedStatus := new(types.ConnectionStatus)
+		JustBeforeEach(func() {
+			*returnedStatus, *returnedErr = freeboxClient.GetConnectionStatus(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"type": "ethernet",
+								"rate_down": 5130,
+								"bytes_up": 12486432,
+								"ipv4_port_range": [16384, 32767],
+								"rate_up": 1229,
+								"bandwidth_up": 700000000,
+								"ipv6": "2a01:e35:xxxx:xxxx::1",
+								"bandwidth_down": 1000000000,
+								"media": "ftth",
+								"state": "up",
+								"bytes_down": 39543223,
+								"ipv4": "82.64.1.1"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct status", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStatus).To(MatchFields(IgnoreExtras, Fields{
+					"State":         Equal(types.ConnectionStateUp),
+					"Type":          Equal(types.ConnectionTypeEthernet),
+					"Media":         Equal(types.ConnectionMediaFTTH),
+					"IPv4":          Equal("82.64.1.1"),
+					"BandwidthDown": Equal(int64(1000000000)),
+					"IPv4PortRange": Equal([]int64{16384, 32767}),
+				}))
+			})
+		})
+		Context("when the server returns an unexpected payload", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": []
+						}`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+		Context("when the context is nil", func() {
+			BeforeEach(func() {
+				ctx = nil
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── Connection configuration ────────────────────────────────────────────────
+
+	Context("getting the connection configuration", func() {
+		returnedConfig := new(types.ConnectionConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetConnectionConfiguration(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"ping": true,
+								"is_secure_pass": false,
+								"remote_access_port": 80,
+								"remote_access": false,
+								"remote_access_min_port": 16384,
+								"remote_access_max_port": 32767,
+								"api_remote_access": true,
+								"wol": false,
+								"adblock": false,
+								"adblock_not_set": false,
+								"allow_token_request": true,
+								"remote_access_ip": "82.64.1.1"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(MatchFields(IgnoreExtras, Fields{
+					"Ping":                Equal(true),
+					"RemoteAccessPort":    Equal(int64(80)),
+					"RemoteAccessMinPort": Equal(int64(16384)),
+					"APIRemoteAccess":     Equal(true),
+					"AllowTokenRequest":   Equal(true),
+					"RemoteAccessIP":      Equal("82.64.1.1"),
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the connection configuration", func() {
+		var (
+			returnedConfig = new(types.ConnectionConfiguration)
+			payload        = new(types.ConnectionConfiguration)
+		)
+		BeforeEach(func() {
+			*payload = types.ConnectionConfiguration{
+				Ping:              false,
+				RemoteAccess:      true,
+				RemoteAccessPort:  20000,
+				APIRemoteAccess:   true,
+				AllowTokenRequest: true,
+			}
+		})
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateConnectionConfiguration(ctx, *payload)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/connection/config/", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"ping": false,
+							"remote_access": true,
+							"remote_access_port": 20000,
+							"api_remote_access": true,
+							"wol": false,
+							"adblock": false,
+							"allow_token_request": true
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"ping": false,
+								"remote_access": true,
+								"remote_access_port": 20000,
+								"api_remote_access": true,
+								"wol": false,
+								"adblock": false,
+								"allow_token_request": true
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(MatchFields(IgnoreExtras, Fields{
+					"Ping":             Equal(false),
+					"RemoteAccess":     Equal(true),
+					"RemoteAccessPort": Equal(int64(20000)),
+				}))
+			})
+		})
+		Context("when the server returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/connection/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "inval",
+							"msg": "Invalid port"
+						}`),
+					),
+				)
+			})
+			It("should return the API error", func() {
+				Expect(*returnedErr).To(MatchError(&client.APIError{Code: "inval"}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── IPv6 configuration ──────────────────────────────────────────────────────
+
+	Context("getting the IPv6 configuration", func() {
+		returnedConfig := new(types.IPv6Configuration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetIPv6Configuration(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/ipv6/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"ipv6_enabled": true,
+								"ipv6_firewall": true,
+								"ipv6ll": "fe80::1",
+								"delegations": [
+									{
+										"prefix": "2a01:e35:xxxx:xxx1::/64",
+										"next_hop": ""
+									}
+								]
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(MatchFields(IgnoreExtras, Fields{
+					"IPv6Enabled":  Equal(true),
+					"IPv6Firewall": Equal(true),
+					"IPv6LLAddr":   Equal("fe80::1"),
+					"Delegations": ConsistOf(types.IPv6Delegation{
+						Prefix: "2a01:e35:xxxx:xxx1::/64",
+					}),
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the IPv6 configuration", func() {
+		returnedConfig := new(types.IPv6Configuration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateIPv6Configuration(ctx, types.IPv6Configuration{
+				IPv6Enabled: false,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/connection/ipv6/config/", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"ipv6_enabled": false,
+							"ipv6_firewall": false
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"ipv6_enabled": false,
+								"ipv6_firewall": false,
+								"ipv6ll": "fe80::1",
+								"delegations": []
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.IPv6Enabled).To(BeFalse())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── DynDNS ──────────────────────────────────────────────────────────────────
+
+	Context("getting the status of a DynDNS service", func() {
+		returnedStatus := new(types.DynDNSStatus)
+		JustBeforeEach(func() {
+			*returnedStatus, *returnedErr = freeboxClient.GetDynDNSStatus(ctx, types.DynDNSProviderOVH)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/ddns/ovh/status/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"status": "ok",
+								"next_refresh": 1700003600,
+								"last_refresh": 1700000000,
+								"next_retry": 0,
+								"last_error": 0
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct status", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStatus).To(MatchFields(IgnoreExtras, Fields{
+					"Status":      Equal(types.DynDNSStatusOK),
+					"LastRefresh": Equal(types.Timestamp{Time: time.Unix(1700000000, 0).UTC()}),
+					"NextRefresh": Equal(types.Timestamp{Time: time.Unix(1700003600, 0).UTC()}),
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting the configuration of a DynDNS service", func() {
+		returnedConfig := new(types.DynDNSConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetDynDNSConfiguration(ctx, types.DynDNSProviderNoIP)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/ddns/noip/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"enabled": true,
+								"hostname": "home.example.org",
+								"user": "alice"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.DynDNSConfiguration{
+					Enabled:  true,
+					Hostname: "home.example.org",
+					User:     "alice",
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the configuration of a DynDNS service", func() {
+		returnedConfig := new(types.DynDNSConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateDynDNSConfiguration(ctx, types.DynDNSProviderDynDNS, types.DynDNSConfiguration{
+				Enabled:  true,
+				Hostname: "home.example.org",
+				User:     "alice",
+				Password: "secret",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/connection/ddns/dyndns/", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"enabled": true,
+							"hostname": "home.example.org",
+							"user": "alice",
+							"password": "secret"
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"enabled": true,
+								"hostname": "home.example.org",
+								"user": "alice"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(MatchFields(IgnoreExtras, Fields{
+					"Enabled":  Equal(true),
+					"Hostname": Equal("home.example.org"),
+					"Password": BeEmpty(),
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
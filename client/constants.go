@@ -17,22 +17,60 @@ const (
 	ErrPrivateTokenIsNotSet       = Error("private token is not set")
 	ErrInterfaceNotFound          = Error("interface not found")
 	ErrDHCPStaticLeaseNotFound    = Error("dhcp static lease not found")
+	ErrHostConflict               = Error("host conflict")
 	ErrInterfaceHostNotFound      = Error("interface host not found")
 	ErrPortForwardingRuleNotFound = Error("port forwarding rule not found")
+	ErrIncomingPortNotFound       = Error("incoming port not found")
+	ErrPortOutsideRange           = Error("port outside range")
 	ErrVirtualMachineNotFound     = Error("virtual machine not found")
 	ErrVirtualMachineNameTooLong  = Error("virtual machine name must be less than 30 characters")
 	ErrPathNotFound               = Error("path not found")
 	ErrTaskNotFound               = Error("task not found")
+	ErrTrackerNotFound            = Error("tracker not found")
 	ErrDestinationConflict        = Error("file or folder already exists")
 	ErrVPNUserNotFound            = Error("vpn user not found")
 	ErrNetworkControlNotFound     = Error("network control not found")
+	ErrNetworkControlRuleNotFound = Error("network control rule not found")
+	ErrProfileNotFound            = Error("profile not found")
+	ErrWifiAccessPointNotFound    = Error("wifi access point not found")
+	ErrWifiBSSNotFound            = Error("wifi bss not found")
+	ErrWifiMacFilterNotFound      = Error("wifi mac filter not found")
+	ErrHomeNodeNotFound           = Error("home node not found")
+	ErrHomeAdapterNotFound        = Error("home adapter not found")
+	ErrHomeEndpointNotFound       = Error("home endpoint not found")
+	ErrHomeLinkNotFound           = Error("home link not found")
+	ErrCameraNotFound             = Error("camera not found")
+	ErrCallEntryNotFound          = Error("call entry not found")
+	ErrContactNotFound            = Error("contact not found")
+	ErrContactNumberNotFound      = Error("contact number not found")
+	ErrContactAddressNotFound     = Error("contact address not found")
+	ErrContactEmailNotFound       = Error("contact email not found")
+	ErrContactURLNotFound         = Error("contact url not found")
+	ErrParentalFilterNotFound     = Error("parental filter not found")
+	ErrFreeplugNotFound           = Error("freeplug not found")
+	ErrSwitchPortNotFound         = Error("switch port not found")
+	ErrAirMediaReceiverNotFound   = Error("airmedia receiver not found")
+	ErrRebootTimeout              = Error("timed out waiting for the freebox to reboot")
+	ErrShareLinkNotFound          = Error("share link not found")
 )
 
 var (
 	// Login.
 	LoginSessionTTL = time.Minute * 30 // Fixed by the freebox server, but made into a variable for unit testing
+	LoginTimeout    = time.Minute      // Bounds a login shared by concurrent requests, which outlives their contexts
 
 	// Authorize.
 	AuthorizeGrantingTimeout = time.Minute * 5
 	AuthorizeRetryDelay      = time.Second * 5
+
+	// Home pairing.
+	HomePairingPollInterval = time.Second
+	HomePairingStopTimeout  = time.Second * 10
+
+	// Reboot.
+	RebootPollInterval = time.Second * 5
+
+	// Rate limiting.
+	RateLimitBackoff    = time.Second      // Pause applied when the freebox first answers ratelimited
+	RateLimitMaxBackoff = time.Second * 30 // The pause doubles every time the freebox keeps answering ratelimited
 )
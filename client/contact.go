@@ -0,0 +1,433 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeContactNotFound = "noent"
+)
+
+// ListContacts returns every contact of the phone book.
+func (c *client) ListContacts(ctx context.Context) ([]types.Contact, error) {
+	response, err := c.get(ctx, "contact/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET contact/ endpoint: %w", err)
+	}
+
+	result := make([]types.Contact, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get contacts from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetContact returns the contact with the given identifier.
+func (c *client) GetContact(ctx context.Context, identifier int64) (contact types.Contact, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("contact/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return contact, ErrContactNotFound
+		}
+
+		return contact, fmt.Errorf("failed to GET contact/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &contact); err != nil {
+		return contact, fmt.Errorf("failed to get contact from generic response: %w", err)
+	}
+
+	return contact, nil
+}
+
+// CreateContact creates a contact.
+func (c *client) CreateContact(ctx context.Context, payload types.ContactPayload) (contact types.Contact, err error) {
+	response, err := c.post(ctx, "contact/", payload, c.withSession(ctx))
+	if err != nil {
+		return contact, fmt.Errorf("failed to POST contact/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &contact); err != nil {
+		return contact, fmt.Errorf("failed to get created contact from generic response: %w", err)
+	}
+
+	return contact, nil
+}
+
+// UpdateContact updates the contact with the given identifier.
+func (c *client) UpdateContact(ctx context.Context, identifier int64, payload types.ContactPayload) (contact types.Contact, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("contact/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return contact, ErrContactNotFound
+		}
+
+		return contact, fmt.Errorf("failed to PUT contact/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &contact); err != nil {
+		return contact, fmt.Errorf("failed to get updated contact from generic response: %w", err)
+	}
+
+	return contact, nil
+}
+
+// DeleteContact deletes the contact with the given identifier.
+func (c *client) DeleteContact(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("contact/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return ErrContactNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE contact/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// ListContactNumbers returns every contact number of the contact with the given identifier.
+func (c *client) ListContactNumbers(ctx context.Context, contactID int64) ([]types.ContactNumber, error) {
+	response, err := c.get(ctx, fmt.Sprintf("contact/%d/numbers/", contactID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return nil, ErrContactNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET contact/%d/numbers/ endpoint: %w", contactID, err)
+	}
+
+	result := make([]types.ContactNumber, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get contact numbers from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetContactNumber returns the contact number with the given identifier.
+func (c *client) GetContactNumber(ctx context.Context, identifier int64) (number types.ContactNumber, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("number/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return number, ErrContactNumberNotFound
+		}
+
+		return number, fmt.Errorf("failed to GET number/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &number); err != nil {
+		return number, fmt.Errorf("failed to get contact number from generic response: %w", err)
+	}
+
+	return number, nil
+}
+
+// CreateContactNumber creates a contact number.
+func (c *client) CreateContactNumber(ctx context.Context, payload types.ContactNumberPayload) (number types.ContactNumber, err error) {
+	response, err := c.post(ctx, "number/", payload, c.withSession(ctx))
+	if err != nil {
+		return number, fmt.Errorf("failed to POST number/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &number); err != nil {
+		return number, fmt.Errorf("failed to get created contact number from generic response: %w", err)
+	}
+
+	return number, nil
+}
+
+// UpdateContactNumber updates the contact number with the given identifier.
+func (c *client) UpdateContactNumber(ctx context.Context, identifier int64, payload types.ContactNumberPayload) (number types.ContactNumber, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("number/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return number, ErrContactNumberNotFound
+		}
+
+		return number, fmt.Errorf("failed to PUT number/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &number); err != nil {
+		return number, fmt.Errorf("failed to get updated contact number from generic response: %w", err)
+	}
+
+	return number, nil
+}
+
+// DeleteContactNumber deletes the contact number with the given identifier.
+func (c *client) DeleteContactNumber(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("number/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return ErrContactNumberNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE number/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// ListContactAddresses returns every contact address of the contact with the given identifier.
+func (c *client) ListContactAddresses(ctx context.Context, contactID int64) ([]types.ContactAddress, error) {
+	response, err := c.get(ctx, fmt.Sprintf("contact/%d/addresses/", contactID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return nil, ErrContactNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET contact/%d/addresses/ endpoint: %w", contactID, err)
+	}
+
+	result := make([]types.ContactAddress, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get contact addresses from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetContactAddress returns the contact address with the given identifier.
+func (c *client) GetContactAddress(ctx context.Context, identifier int64) (address types.ContactAddress, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("address/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return address, ErrContactAddressNotFound
+		}
+
+		return address, fmt.Errorf("failed to GET address/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &address); err != nil {
+		return address, fmt.Errorf("failed to get contact address from generic response: %w", err)
+	}
+
+	return address, nil
+}
+
+// CreateContactAddress creates a contact address.
+func (c *client) CreateContactAddress(ctx context.Context, payload types.ContactAddressPayload) (address types.ContactAddress, err error) {
+	response, err := c.post(ctx, "address/", payload, c.withSession(ctx))
+	if err != nil {
+		return address, fmt.Errorf("failed to POST address/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &address); err != nil {
+		return address, fmt.Errorf("failed to get created contact address from generic response: %w", err)
+	}
+
+	return address, nil
+}
+
+// UpdateContactAddress updates the contact address with the given identifier.
+func (c *client) UpdateContactAddress(ctx context.Context, identifier int64, payload types.ContactAddressPayload) (address types.ContactAddress, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("address/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return address, ErrContactAddressNotFound
+		}
+
+		return address, fmt.Errorf("failed to PUT address/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &address); err != nil {
+		return address, fmt.Errorf("failed to get updated contact address from generic response: %w", err)
+	}
+
+	return address, nil
+}
+
+// DeleteContactAddress deletes the contact address with the given identifier.
+func (c *client) DeleteContactAddress(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("address/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return ErrContactAddressNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE address/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// ListContactEmails returns every contact email of the contact with the given identifier.
+func (c *client) ListContactEmails(ctx context.Context, contactID int64) ([]types.ContactEmail, error) {
+	response, err := c.get(ctx, fmt.Sprintf("contact/%d/emails/", contactID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return nil, ErrContactNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET contact/%d/emails/ endpoint: %w", contactID, err)
+	}
+
+	result := make([]types.ContactEmail, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get contact emails from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetContactEmail returns the contact email with the given identifier.
+func (c *client) GetContactEmail(ctx context.Context, identifier int64) (email types.ContactEmail, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("email/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return email, ErrContactEmailNotFound
+		}
+
+		return email, fmt.Errorf("failed to GET email/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &email); err != nil {
+		return email, fmt.Errorf("failed to get contact email from generic response: %w", err)
+	}
+
+	return email, nil
+}
+
+// CreateContactEmail creates a contact email.
+func (c *client) CreateContactEmail(ctx context.Context, payload types.ContactEmailPayload) (email types.ContactEmail, err error) {
+	response, err := c.post(ctx, "email/", payload, c.withSession(ctx))
+	if err != nil {
+		return email, fmt.Errorf("failed to POST email/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &email); err != nil {
+		return email, fmt.Errorf("failed to get created contact email from generic response: %w", err)
+	}
+
+	return email, nil
+}
+
+// UpdateContactEmail updates the contact email with the given identifier.
+func (c *client) UpdateContactEmail(ctx context.Context, identifier int64, payload types.ContactEmailPayload) (email types.ContactEmail, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("email/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return email, ErrContactEmailNotFound
+		}
+
+		return email, fmt.Errorf("failed to PUT email/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &email); err != nil {
+		return email, fmt.Errorf("failed to get updated contact email from generic response: %w", err)
+	}
+
+	return email, nil
+}
+
+// DeleteContactEmail deletes the contact email with the given identifier.
+func (c *client) DeleteContactEmail(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("email/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return ErrContactEmailNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE email/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// ListContactURLs returns every contact URL of the contact with the given identifier.
+func (c *client) ListContactURLs(ctx context.Context, contactID int64) ([]types.ContactURL, error) {
+	response, err := c.get(ctx, fmt.Sprintf("contact/%d/urls/", contactID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return nil, ErrContactNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET contact/%d/urls/ endpoint: %w", contactID, err)
+	}
+
+	result := make([]types.ContactURL, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get contact URLs from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetContactURL returns the contact URL with the given identifier.
+func (c *client) GetContactURL(ctx context.Context, identifier int64) (url types.ContactURL, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("url/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return url, ErrContactURLNotFound
+		}
+
+		return url, fmt.Errorf("failed to GET url/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &url); err != nil {
+		return url, fmt.Errorf("failed to get contact URL from generic response: %w", err)
+	}
+
+	return url, nil
+}
+
+// CreateContactURL creates a contact URL.
+func (c *client) CreateContactURL(ctx context.Context, payload types.ContactURLPayload) (url types.ContactURL, err error) {
+	response, err := c.post(ctx, "url/", payload, c.withSession(ctx))
+	if err != nil {
+		return url, fmt.Errorf("failed to POST url/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &url); err != nil {
+		return url, fmt.Errorf("failed to get created contact URL from generic response: %w", err)
+	}
+
+	return url, nil
+}
+
+// UpdateContactURL updates the contact URL with the given identifier.
+func (c *client) UpdateContactURL(ctx context.Context, identifier int64, payload types.ContactURLPayload) (url types.ContactURL, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("url/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return url, ErrContactURLNotFound
+		}
+
+		return url, fmt.Errorf("failed to PUT url/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &url); err != nil {
+		return url, fmt.Errorf("failed to get updated contact URL from generic response: %w", err)
+	}
+
+	return url, nil
+}
+
+// DeleteContactURL deletes the contact URL with the given identifier.
+func (c *client) DeleteContactURL(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("url/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeContactNotFound {
+			return ErrContactURLNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE url/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,786 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("contact", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing contacts", func() {
+		returnedContacts := new([]types.Contact)
+		JustBeforeEach(func() {
+			*returnedContacts, *returnedErr = freeboxClient.ListContacts(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"display_name": "Jean Dupont",
+									"first_name": "Jean",
+									"last_name": "Dupont",
+									"company": "Free",
+									"photo_url": "",
+									"last_update": 1355048186,
+									"notes": ""
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct contacts", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedContacts).To(HaveLen(1))
+				Expect((*returnedContacts)[0].ID).To(Equal(int64(1)))
+				Expect((*returnedContacts)[0].ContactPayload).To(Equal(types.ContactPayload{
+					DisplayName: "Jean Dupont",
+					FirstName:   "Jean",
+					LastName:    "Dupont",
+					Company:     "Free",
+				}))
+				Expect((*returnedContacts)[0].LastUpdate.Unix()).To(Equal(int64(1355048186)))
+			})
+		})
+		Context("when there are no contacts", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedContacts).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a contact", func() {
+		returnedContact := new(types.Contact)
+		JustBeforeEach(func() {
+			*returnedContact, *returnedErr = freeboxClient.GetContact(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 1,
+								"display_name": "Jean Dupont",
+								"last_update": 1355048186,
+								"numbers": [
+									{"id": 3, "contact_id": 1, "type": "mobile", "number": "0612345678", "is_default": true, "is_own": false}
+								]
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the contact with its numbers", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedContact.DisplayName).To(Equal("Jean Dupont"))
+				Expect(returnedContact.Numbers).To(Equal([]types.ContactNumber{{
+					ID: 3,
+					ContactNumberPayload: types.ContactNumberPayload{
+						ContactID: 1,
+						Type:      types.ContactNumberTypeMobile,
+						Number:    "0612345678",
+						IsDefault: true,
+					},
+				}}))
+			})
+		})
+		Context("when the contact is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("creating a contact", func() {
+		returnedContact := new(types.Contact)
+		JustBeforeEach(func() {
+			*returnedContact, *returnedErr = freeboxClient.CreateContact(ctx, types.ContactPayload{
+				DisplayName: "Jean Dupont",
+				FirstName:   "Jean",
+				LastName:    "Dupont",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/contact/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"display_name": "Jean Dupont",
+							"first_name": "Jean",
+							"last_name": "Dupont",
+							"company": "",
+							"birthday": "",
+							"notes": ""
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 7, "display_name": "Jean Dupont", "last_update": 1355048186}
+						}`),
+					),
+				)
+			})
+			It("should return the created contact", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedContact.ID).To(Equal(int64(7)))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a contact", func() {
+		returnedContact := new(types.Contact)
+		JustBeforeEach(func() {
+			*returnedContact, *returnedErr = freeboxClient.UpdateContact(ctx, 7, types.ContactPayload{DisplayName: "Jean"})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/contact/7", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 7, "display_name": "Jean", "last_update": 1355048186}
+						}`),
+					),
+				)
+			})
+			It("should return the updated contact", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedContact.DisplayName).To(Equal("Jean"))
+			})
+		})
+		Context("when the contact is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/contact/7", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactNotFound))
+			})
+		})
+	})
+
+	Context("deleting a contact", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteContact(ctx, 7)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/contact/7", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the contact is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/contact/7", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactNotFound))
+			})
+		})
+	})
+
+	Context("listing contact numbers", func() {
+		returnedNumbers := new([]types.ContactNumber)
+		JustBeforeEach(func() {
+			*returnedNumbers, *returnedErr = freeboxClient.ListContactNumbers(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/1/numbers/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{"id": 3, "contact_id": 1, "type": "fixed", "number": "0102030405", "is_default": false, "is_own": true}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct numbers", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNumbers).To(Equal([]types.ContactNumber{{
+					ID:    3,
+					IsOwn: true,
+					ContactNumberPayload: types.ContactNumberPayload{
+						ContactID: 1,
+						Type:      types.ContactNumberTypeFixed,
+						Number:    "0102030405",
+					},
+				}}))
+			})
+		})
+		Context("when the contact is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/1/numbers/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactNotFound))
+			})
+		})
+	})
+
+	Context("getting a contact number", func() {
+		returnedNumber := new(types.ContactNumber)
+		JustBeforeEach(func() {
+			*returnedNumber, *returnedErr = freeboxClient.GetContactNumber(ctx, 3)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/number/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 3, "contact_id": 1, "type": "work", "number": "0102030405"}
+						}`),
+					),
+				)
+			})
+			It("should return the correct number", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedNumber.Type).To(Equal(types.ContactNumberTypeWork))
+			})
+		})
+		Context("when the number is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/number/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactNumberNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactNumberNotFound))
+			})
+		})
+	})
+
+	Context("creating a contact address", func() {
+		returnedAddress := new(types.ContactAddress)
+		JustBeforeEach(func() {
+			*returnedAddress, *returnedErr = freeboxClient.CreateContactAddress(ctx, types.ContactAddressPayload{
+				ContactID: 1,
+				Type:      types.ContactAddressTypeHome,
+				Number:    "8",
+				Street:    "rue de la Ville l'Evêque",
+				City:      "Paris",
+				ZipCode:   "75008",
+				Country:   "France",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/address/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"contact_id": 1,
+							"type": "home",
+							"number": "8",
+							"street": "rue de la Ville l'Evêque",
+							"street2": "",
+							"city": "Paris",
+							"zipcode": "75008",
+							"country": "France"
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 4, "contact_id": 1, "type": "home", "city": "Paris"}
+						}`),
+					),
+				)
+			})
+			It("should return the created address", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedAddress.ID).To(Equal(int64(4)))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a contact email", func() {
+		returnedEmail := new(types.ContactEmail)
+		JustBeforeEach(func() {
+			*returnedEmail, *returnedErr = freeboxClient.UpdateContactEmail(ctx, 5, types.ContactEmailPayload{
+				ContactID: 1,
+				Type:      types.ContactEmailTypeWork,
+				Email:     "jean@example.com",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/email/5", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"contact_id": 1, "type": "work", "email": "jean@example.com"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 5, "contact_id": 1, "type": "work", "email": "jean@example.com"}
+						}`),
+					),
+				)
+			})
+			It("should return the updated email", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedEmail.Email).To(Equal("jean@example.com"))
+			})
+		})
+		Context("when the email is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/email/5", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactEmailNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactEmailNotFound))
+			})
+		})
+	})
+
+	Context("deleting a contact URL", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteContactURL(ctx, 6)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/url/6", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the URL is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/url/6", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrContactURLNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrContactURLNotFound))
+			})
+		})
+	})
+
+	Context("exporting contacts as vCard", func() {
+		writer := new(bytes.Buffer)
+		JustBeforeEach(func() {
+			writer.Reset()
+			*returnedErr = freeboxClient.ExportContactsVCard(ctx, writer)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"display_name": "Jean Dupont",
+									"first_name": "Jean",
+									"last_name": "Dupont",
+									"company": "Free, SAS",
+									"notes": "first line\nsecond line",
+									"last_update": 1355048186
+								}
+							]
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 1,
+								"display_name": "Jean Dupont",
+								"first_name": "Jean",
+								"last_name": "Dupont",
+								"company": "Free, SAS",
+								"notes": "first line\nsecond line",
+								"last_update": 1355048186,
+								"numbers": [
+									{"id": 3, "contact_id": 1, "type": "mobile", "number": "0612345678", "is_default": true},
+									{"id": 4, "contact_id": 1, "type": "other", "number": "0102030405"}
+								],
+								"addresses": [
+									{"id": 5, "contact_id": 1, "type": "work", "number": "8", "street": "rue de la Ville l'Evêque", "city": "Paris", "zipcode": "75008", "country": "France"}
+								],
+								"emails": [{"id": 6, "contact_id": 1, "type": "home", "email": "jean@example.com"}]
+							}
+						}`),
+					),
+				)
+			})
+			It("should write the contacts as vCards", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+				Expect(writer.String()).To(Equal(strings.Join([]string{
+					"BEGIN:VCARD",
+					"VERSION:3.0",
+					"FN:Jean Dupont",
+					"N:Dupont;Jean;;;",
+					`ORG:Free\, SAS`,
+					`NOTE:first line\nsecond line`,
+					"TEL;TYPE=CELL,PREF:0612345678",
+					"TEL:0102030405",
+					"ADR;TYPE=WORK:;;8,rue de la Ville l'Evêque;Paris;;75008;France",
+					"EMAIL;TYPE=HOME:jean@example.com",
+					"END:VCARD",
+					"",
+				}, "\r\n")))
+			})
+		})
+		Context("when a contact cannot be retrieved", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": [{"id": 1, "last_update": 0}]}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/contact/1", version)),
+						ghttp.RespondWith(http.StatusInternalServerError, ``),
+					),
+				)
+			})
+			It("should return an error and write nothing", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+				Expect(writer.Len()).To(BeZero())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("importing contacts from vCard", func() {
+		var (
+			reader           = new(string)
+			returnedContacts = new([]types.Contact)
+		)
+		JustBeforeEach(func() {
+			*returnedContacts, *returnedErr = freeboxClient.ImportContactsVCard(ctx, strings.NewReader(*reader))
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				*reader = strings.Join([]string{
+					"BEGIN:VCARD",
+					"VERSION:3.0",
+					"N:Dupont;Jean;;;",
+					`ORG:Free\, SAS;R&D`,
+					"NOTE:a very long note that is folded over multiple lines because it is",
+					"  longer than seventy five characters",
+					"item1.TEL;TYPE=cell;TYPE=pref:+33612345678",
+					"TEL;HOME;VOICE:0102030405",
+					"ADR;TYPE=WORK:;;8,rue de la Ville l'Evêque;Paris;;75008;France",
+					"EMAIL;TYPE=INTERNET:jean@example.com",
+					"URL:https://example.com",
+					"END:VCARD",
+				}, "\r\n")
+
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/contact/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"display_name": "Jean Dupont",
+							"first_name": "Jean",
+							"last_name": "Dupont",
+							"company": "Free, SAS",
+							"birthday": "",
+							"notes": "a very long note that is folded over multiple lines because it is longer than seventy five characters"
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 9, "display_name": "Jean Dupont", "last_update": 0}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/number/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"contact_id": 9, "type": "mobile", "number": "+33612345678", "is_default": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 1}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/number/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"contact_id": 9, "type": "fixed", "number": "0102030405", "is_default": false}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 2}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/address/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"contact_id": 9,
+							"type": "work",
+							"number": "8",
+							"street": "rue de la Ville l'Evêque",
+							"street2": "",
+							"city": "Paris",
+							"zipcode": "75008",
+							"country": "France"
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 3}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/email/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"contact_id": 9, "type": "other", "email": "jean@example.com"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 4}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/url/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"contact_id": 9, "type": "other", "url": "https://example.com"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 5}}`),
+					),
+				)
+			})
+			It("should create the contacts along with their details", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedContacts).To(HaveLen(1))
+				Expect((*returnedContacts)[0].ID).To(Equal(int64(9)))
+			})
+		})
+		Context("when the street of an address spans multiple lines", func() {
+			BeforeEach(func() {
+				*reader = strings.Join([]string{
+					"BEGIN:VCARD",
+					"FN:Jean",
+					`ADR;TYPE=HOME:;Résidence les Pins;Bât. B,12 rue X;Paris;;75001;France`,
+					"END:VCARD",
+				}, "\r\n")
+
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/contact/", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 9, "display_name": "Jean", "last_update": 0}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/address/", version)),
+						ghttp.VerifyJSON(`{
+							"contact_id": 9,
+							"type": "home",
+							"number": "",
+							"street": "12 rue X",
+							"street2": "Résidence les Pins, Bât. B",
+							"city": "Paris",
+							"zipcode": "75001",
+							"country": "France"
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 3}}`),
+					),
+				)
+			})
+			It("should keep the extra lines out of the number", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedContacts).To(HaveLen(1))
+			})
+		})
+		Context("when the vCard is not terminated", func() {
+			BeforeEach(func() {
+				*reader = "BEGIN:VCARD\r\nFN:Jean\r\n"
+			})
+			It("should return an error without creating anything", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("missing end of vcard")))
+				Expect(*returnedContacts).To(BeNil())
+			})
+		})
+		Context("when a line is invalid", func() {
+			BeforeEach(func() {
+				*reader = "BEGIN:VCARD\r\ngarbage\r\nEND:VCARD\r\n"
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("invalid line 2")))
+			})
+		})
+		Context("when the contact creation fails", func() {
+			BeforeEach(func() {
+				*reader = "BEGIN:VCARD\r\nFN:Jean\r\nEND:VCARD\r\n"
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/contact/", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": false, "error_code": "inval", "msg": "invalid contact"}`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("invalid contact")))
+				Expect(*returnedContacts).To(BeEmpty())
+			})
+		})
+		Context("when a detail of the contact cannot be created", func() {
+			BeforeEach(func() {
+				*reader = "BEGIN:VCARD\r\nFN:Jean\r\nTEL:0102030405\r\nEND:VCARD\r\nBEGIN:VCARD\r\nFN:Marie\r\nEND:VCARD\r\n"
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/contact/", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 9, "display_name": "Jean", "last_update": 0}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/number/", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": false, "error_code": "inval", "msg": "invalid number"}`),
+					),
+				)
+			})
+			It("should return the partially created contact along with the error", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("invalid number")))
+				Expect(*returnedContacts).To(HaveLen(1))
+				Expect((*returnedContacts)[0].ID).To(Equal(int64(9)))
+			})
+		})
+	})
+})
@@ -0,0 +1,527 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	vCardVersion       = "3.0"
+	vCardMaxLineLength = 75
+)
+
+// vCard is a contact along with its numbers, addresses, emails and URLs, as represented in a vCard.
+type vCard struct {
+	contact   types.ContactPayload
+	numbers   []types.ContactNumberPayload
+	addresses []types.ContactAddressPayload
+	emails    []types.ContactEmailPayload
+	urls      []types.ContactURLPayload
+}
+
+// ExportContactsVCard writes every contact of the phone book to the given writer, as vCard 3.0 entries.
+// The number of an address is written as the first value of the street component, such as "8,rue de la Paix".
+func (c *client) ExportContactsVCard(ctx context.Context, writer io.Writer) error {
+	contacts, err := c.ListContacts(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list contacts: %w", err)
+	}
+
+	buffer := bufio.NewWriter(writer)
+
+	for _, contact := range contacts {
+		card, err := c.getContactVCard(ctx, contact.ID)
+		if err != nil {
+			return err
+		}
+
+		if err = card.write(buffer); err != nil {
+			return fmt.Errorf("failed to write vcard of contact %d: %w", contact.ID, err)
+		}
+	}
+
+	if err = buffer.Flush(); err != nil {
+		return fmt.Errorf("failed to flush vcards: %w", err)
+	}
+
+	return nil
+}
+
+// ImportContactsVCard creates a contact for every vCard entry read from the given reader,
+// along with its numbers, addresses, emails and URLs. It returns the created contacts, including on failure
+// the one whose details could not all be created.
+func (c *client) ImportContactsVCard(ctx context.Context, reader io.Reader) ([]types.Contact, error) {
+	cards, err := readVCards(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read vcards: %w", err)
+	}
+
+	created := make([]types.Contact, 0, len(cards))
+
+	for _, card := range cards {
+		contact, err := c.createContactFromVCard(ctx, card)
+		if err != nil {
+			if contact.ID != 0 {
+				created = append(created, contact)
+			}
+
+			return created, err
+		}
+
+		created = append(created, contact)
+	}
+
+	return created, nil
+}
+
+func (c *client) getContactVCard(ctx context.Context, identifier int64) (card vCard, err error) {
+	contact, err := c.GetContact(ctx, identifier)
+	if err != nil {
+		return card, fmt.Errorf("failed to get contact %d: %w", identifier, err)
+	}
+
+	card.contact = contact.ContactPayload
+
+	for _, number := range contact.Numbers {
+		card.numbers = append(card.numbers, number.ContactNumberPayload)
+	}
+
+	for _, address := range contact.Addresses {
+		card.addresses = append(card.addresses, address.ContactAddressPayload)
+	}
+
+	for _, email := range contact.Emails {
+		card.emails = append(card.emails, email.ContactEmailPayload)
+	}
+
+	for _, url := range contact.URLs {
+		card.urls = append(card.urls, url.ContactURLPayload)
+	}
+
+	return card, nil
+}
+
+func (c *client) createContactFromVCard(ctx context.Context, card vCard) (contact types.Contact, err error) {
+	contact, err = c.CreateContact(ctx, card.contact)
+	if err != nil {
+		return contact, fmt.Errorf("failed to create contact %q: %w", card.contact.DisplayName, err)
+	}
+
+	for _, number := range card.numbers {
+		number.ContactID = contact.ID
+		if _, err = c.CreateContactNumber(ctx, number); err != nil {
+			return contact, fmt.Errorf("failed to create number of contact %d: %w", contact.ID, err)
+		}
+	}
+
+	for _, address := range card.addresses {
+		address.ContactID = contact.ID
+		if _, err = c.CreateContactAddress(ctx, address); err != nil {
+			return contact, fmt.Errorf("failed to create address of contact %d: %w", contact.ID, err)
+		}
+	}
+
+	for _, email := range card.emails {
+		email.ContactID = contact.ID
+		if _, err = c.CreateContactEmail(ctx, email); err != nil {
+			return contact, fmt.Errorf("failed to create email of contact %d: %w", contact.ID, err)
+		}
+	}
+
+	for _, url := range card.urls {
+		url.ContactID = contact.ID
+		if _, err = c.CreateContactURL(ctx, url); err != nil {
+			return contact, fmt.Errorf("failed to create url of contact %d: %w", contact.ID, err)
+		}
+	}
+
+	return contact, nil
+}
+
+func (card vCard) write(writer io.Writer) error {
+	lines := []string{
+		"BEGIN:VCARD",
+		"VERSION:" + vCardVersion,
+		"FN:" + escapeVCardValue(card.contact.DisplayName),
+		"N:" + escapeVCardValue(card.contact.LastName) + ";" + escapeVCardValue(card.contact.FirstName) + ";;;",
+	}
+
+	if card.contact.Company != "" {
+		lines = append(lines, "ORG:"+escapeVCardValue(card.contact.Company))
+	}
+
+	if card.contact.Birthday != "" {
+		lines = append(lines, "BDAY:"+escapeVCardValue(card.contact.Birthday))
+	}
+
+	if card.contact.Notes != "" {
+		lines = append(lines, "NOTE:"+escapeVCardValue(card.contact.Notes))
+	}
+
+	for _, number := range card.numbers {
+		lines = append(lines, "TEL"+vCardTypeParameter(vCardNumberTypes[number.Type], number.IsDefault)+":"+escapeVCardValue(number.Number))
+	}
+
+	for _, address := range card.addresses {
+		street := escapeVCardValue(address.Street)
+		if address.Number != "" {
+			street = escapeVCardValue(address.Number) + "," + street
+		}
+
+		lines = append(lines, "ADR"+vCardTypeParameter(string(address.Type), false)+":"+strings.Join([]string{
+			"",
+			escapeVCardValue(address.Street2),
+			street,
+			escapeVCardValue(address.City),
+			"",
+			escapeVCardValue(address.ZipCode),
+			escapeVCardValue(address.Country),
+		}, ";"))
+	}
+
+	for _, email := range card.emails {
+		lines = append(lines, "EMAIL"+vCardTypeParameter(string(email.Type), false)+":"+escapeVCardValue(email.Email))
+	}
+
+	for _, url := range card.urls {
+		lines = append(lines, "URL"+vCardTypeParameter(string(url.Type), false)+":"+escapeVCardValue(url.URL))
+	}
+
+	lines = append(lines, "END:VCARD")
+
+	for _, line := range lines {
+		if _, err := io.WriteString(writer, foldVCardLine(line)+"\r\n"); err != nil {
+			return fmt.Errorf("failed to write line: %w", err)
+		}
+	}
+
+	return nil
+}
+
+var vCardNumberTypes = map[types.ContactNumberType]string{
+	types.ContactNumberTypeFixed:  "home",
+	types.ContactNumberTypeMobile: "cell",
+	types.ContactNumberTypeWork:   "work",
+	types.ContactNumberTypeFax:    "fax",
+}
+
+func vCardTypeParameter(value string, preferred bool) string {
+	values := make([]string, 0, 2)
+	if value != "" && value != "other" {
+		values = append(values, strings.ToUpper(value))
+	}
+
+	if preferred {
+		values = append(values, "PREF")
+	}
+
+	if len(values) == 0 {
+		return ""
+	}
+
+	return ";TYPE=" + strings.Join(values, ",")
+}
+
+func escapeVCardValue(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		",", `\,`,
+		";", `\;`,
+	).Replace(value)
+}
+
+func unescapeVCardValue(value string) string {
+	builder := strings.Builder{}
+	escaped := false
+
+	for _, character := range value {
+		switch {
+		case escaped && (character == 'n' || character == 'N'):
+			builder.WriteRune('\n')
+		case escaped:
+			builder.WriteRune(character)
+		case character == '\\':
+			escaped = true
+
+			continue
+		default:
+			builder.WriteRune(character)
+		}
+
+		escaped = false
+	}
+
+	return builder.String()
+}
+
+// splitVCardValue splits a structured value on its unescaped separators, and unescapes every component.
+func splitVCardValue(value string) []string {
+	components := splitEscapedVCardValue(value, ';')
+	for index, component := range components {
+		components[index] = unescapeVCardValue(component)
+	}
+
+	return components
+}
+
+// splitEscapedVCardValue splits a value on the unescaped occurrences of the given separator, leaving the parts escaped.
+func splitEscapedVCardValue(value string, separator rune) []string {
+	parts := make([]string, 0)
+	start := 0
+	escaped := false
+
+	for index, character := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case character == '\\':
+			escaped = true
+		case character == separator:
+			parts = append(parts, value[start:index])
+			start = index + 1
+		}
+	}
+
+	return append(parts, value[start:])
+}
+
+// foldVCardLine splits lines longer than 75 octets as described in RFC 2425 section 5.8.1,
+// without breaking multi-byte characters.
+func foldVCardLine(line string) string {
+	builder := strings.Builder{}
+	length := 0
+
+	for _, character := range line {
+		size := len(string(character))
+		if length+size > vCardMaxLineLength {
+			builder.WriteString("\r\n ")
+
+			length = 1
+		}
+
+		builder.WriteRune(character)
+
+		length += size
+	}
+
+	return builder.String()
+}
+
+func readVCards(reader io.Reader) ([]vCard, error) {
+	lines, err := readUnfoldedVCardLines(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	cards := make([]vCard, 0)
+
+	var current *vCard
+
+	for index, line := range lines {
+		name, parameters, value, found := parseVCardLine(line)
+		if !found {
+			return nil, fmt.Errorf("invalid line %d: %q", index+1, line)
+		}
+
+		switch {
+		case name == "BEGIN" && strings.EqualFold(value, "VCARD"):
+			if current != nil {
+				return nil, fmt.Errorf("unexpected nested vcard at line %d", index+1)
+			}
+
+			current = new(vCard)
+		case name == "END" && strings.EqualFold(value, "VCARD"):
+			if current == nil {
+				return nil, fmt.Errorf("unexpected end of vcard at line %d", index+1)
+			}
+
+			if current.contact.DisplayName == "" {
+				current.contact.DisplayName = strings.TrimSpace(current.contact.FirstName + " " + current.contact.LastName)
+			}
+
+			cards = append(cards, *current)
+			current = nil
+		case current == nil:
+			return nil, fmt.Errorf("unexpected property %s outside of a vcard at line %d", name, index+1)
+		default:
+			current.set(name, parameters, value)
+		}
+	}
+
+	if current != nil {
+		return nil, fmt.Errorf("missing end of vcard")
+	}
+
+	return cards, nil
+}
+
+func readUnfoldedVCardLines(reader io.Reader) ([]string, error) {
+	lines := make([]string, 0)
+	scanner := bufio.NewScanner(reader)
+
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+
+		switch {
+		case line == "":
+			continue
+		case (line[0] == ' ' || line[0] == '\t') && len(lines) > 0:
+			lines[len(lines)-1] += line[1:]
+		default:
+			lines = append(lines, line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan lines: %w", err)
+	}
+
+	return lines, nil
+}
+
+// parseVCardLine returns the upper cased name of the property, the lower cased values of its TYPE
+// parameters and its raw value.
+func parseVCardLine(line string) (name string, parameters map[string]bool, value string, found bool) {
+	head, value, found := strings.Cut(line, ":")
+	if !found {
+		return "", nil, "", false
+	}
+
+	fields := strings.Split(head, ";")
+
+	name = strings.ToUpper(fields[0])
+	if _, property, grouped := strings.Cut(name, "."); grouped {
+		name = property
+	}
+
+	parameters = make(map[string]bool)
+
+	for _, parameter := range fields[1:] {
+		key, values, assigned := strings.Cut(parameter, "=")
+		if !assigned {
+			// vCard 2.1 style bare type parameter
+			values = key
+		} else if !strings.EqualFold(key, "TYPE") {
+			continue
+		}
+
+		for _, v := range strings.Split(values, ",") {
+			parameters[strings.ToLower(strings.Trim(v, `"`))] = true
+		}
+	}
+
+	return name, parameters, value, true
+}
+
+func (card *vCard) set(name string, parameters map[string]bool, value string) {
+	switch name {
+	case "FN":
+		card.contact.DisplayName = unescapeVCardValue(value)
+	case "N":
+		components := splitVCardValue(value)
+		card.contact.LastName = components[0]
+
+		if len(components) > 1 {
+			card.contact.FirstName = components[1]
+		}
+	case "ORG":
+		card.contact.Company = splitVCardValue(value)[0]
+	case "BDAY":
+		card.contact.Birthday = unescapeVCardValue(value)
+	case "NOTE":
+		card.contact.Notes = unescapeVCardValue(value)
+	case "TEL":
+		card.numbers = append(card.numbers, types.ContactNumberPayload{
+			Type:      vCardNumberType(parameters),
+			Number:    unescapeVCardValue(value),
+			IsDefault: parameters["pref"],
+		})
+	case "ADR":
+		components := append(splitEscapedVCardValue(value, ';'), make([]string, 7)...)
+		for index, component := range components {
+			if index != 2 {
+				components[index] = unescapeVCardValue(component)
+			}
+		}
+
+		number, street, street2 := splitVCardStreet(components[2], components[1])
+		card.addresses = append(card.addresses, types.ContactAddressPayload{
+			Type:    types.ContactAddressType(vCardType(parameters, "home", "work")),
+			Number:  number,
+			Street2: street2,
+			Street:  street,
+			City:    components[3],
+			ZipCode: components[5],
+			Country: components[6],
+		})
+	case "EMAIL":
+		card.emails = append(card.emails, types.ContactEmailPayload{
+			Type:  types.ContactEmailType(vCardType(parameters, "home", "work")),
+			Email: unescapeVCardValue(value),
+		})
+	case "URL":
+		card.urls = append(card.urls, types.ContactURLPayload{
+			Type: types.ContactURLType(vCardType(parameters, "profile", "blog", "site")),
+			URL:  unescapeVCardValue(value),
+		})
+	}
+}
+
+var matchAddressNumberRegex = regexp.MustCompile(`^\d+\s*(?i:bis|ter)?$`)
+
+// splitVCardStreet splits the escaped street component of an address, which may be a list. It starts with the
+// number of the address when exported by ExportContactsVCard, while other applications list extra address lines
+// before the street itself: those are added to the given extended address.
+func splitVCardStreet(component, extended string) (number, street, street2 string) {
+	values := splitEscapedVCardValue(component, ',')
+	for index, value := range values {
+		values[index] = unescapeVCardValue(value)
+	}
+
+	if len(values) > 1 && matchAddressNumberRegex.MatchString(values[0]) {
+		return values[0], strings.Join(values[1:], ","), extended
+	}
+
+	lines := values[:len(values)-1]
+	if extended != "" {
+		lines = append([]string{extended}, lines...)
+	}
+
+	return "", values[len(values)-1], strings.Join(lines, ", ")
+}
+
+func vCardNumberType(parameters map[string]bool) types.ContactNumberType {
+	for _, numberType := range []types.ContactNumberType{
+		types.ContactNumberTypeMobile,
+		types.ContactNumberTypeFax,
+		types.ContactNumberTypeWork,
+	} {
+		if parameters[vCardNumberTypes[numberType]] {
+			return numberType
+		}
+	}
+
+	if parameters["home"] || parameters["voice"] {
+		return types.ContactNumberTypeFixed
+	}
+
+	return types.ContactNumberTypeOther
+}
+
+func vCardType(parameters map[string]bool, known ...string) string {
+	for _, value := range known {
+		if parameters[value] {
+			return value
+		}
+	}
+
+	return "other"
+}
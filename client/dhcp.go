@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+func (c *client) GetDHCPConfiguration(ctx context.Context) (result types.DHCPConfiguration, err error) {
+	response, err := c.get(ctx, "dhcp/config/", c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to GET dhcp/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get dhcp configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateDHCPConfiguration(ctx context.Context, payload types.DHCPConfiguration) (result types.DHCPConfiguration, err error) {
+	response, err := c.put(ctx, "dhcp/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to PUT dhcp/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update dhcp configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) GetDHCPv6Configuration(ctx context.Context) (result types.DHCPv6Configuration, err error) {
+	response, err := c.get(ctx, "dhcpv6/config/", c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to GET dhcpv6/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get dhcpv6 configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateDHCPv6Configuration(ctx context.Context, payload types.DHCPv6Configuration) (result types.DHCPv6Configuration, err error) {
+	response, err := c.put(ctx, "dhcpv6/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to PUT dhcpv6/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update dhcpv6 configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) ListDHCPDynamicLeases(ctx context.Context) (result []types.DHCPDynamicLease, err error) {
+	response, err := c.get(ctx, "dhcp/dynamic_lease/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET dhcp/dynamic_lease/ endpoint: %w", err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to list dhcp dynamic leases from generic response: %w", err)
+	}
+
+	return result, nil
+}
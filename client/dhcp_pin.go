@@ -0,0 +1,166 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+type HostConflictReason string
+
+const (
+	HostConflictReasonOutsideDHCPRange HostConflictReason = "outside_dhcp_range" // The IP is not within the DHCP range
+	HostConflictReasonStaticLease      HostConflictReason = "static_lease"       // The IP is already assigned to another host by a static lease
+	HostConflictReasonActiveHost       HostConflictReason = "active_host"        // The IP is currently used by another host on the LAN
+)
+
+// HostConflictError is returned by PinHost when the requested IP cannot be assigned to the host.
+// It matches ErrHostConflict with errors.Is.
+type HostConflictError struct {
+	Reason HostConflictReason
+	Mac    string // MAC address of the host to pin
+	IP     string // Requested IP
+
+	// Conflicting static lease or host, unset for HostConflictReasonOutsideDHCPRange
+	ConflictingID   string
+	ConflictingMac  string
+	ConflictingName string
+
+	// DHCP range, only set for HostConflictReasonOutsideDHCPRange
+	RangeStart string
+	RangeEnd   string
+}
+
+func (e *HostConflictError) Error() string {
+	switch e.Reason {
+	case HostConflictReasonOutsideDHCPRange:
+		return fmt.Sprintf("%s: %s is outside of the DHCP range %s-%s", ErrHostConflict, e.IP, e.RangeStart, e.RangeEnd)
+	case HostConflictReasonStaticLease:
+		return fmt.Sprintf("%s: %s is already assigned to %s by static lease %q", ErrHostConflict, e.IP, e.ConflictingMac, e.ConflictingID)
+	default:
+		return fmt.Sprintf("%s: %s is in use by host %q (%s)", ErrHostConflict, e.IP, e.ConflictingName, e.ConflictingMac)
+	}
+}
+
+func (e *HostConflictError) Unwrap() error {
+	return ErrHostConflict
+}
+
+// PinHost assigns the given IP to the host with the given MAC address with a DHCP static lease, updating the
+// existing lease of the host if any. Before doing so, it checks that the IP is within the DHCP range, that no
+// static lease assigns it to another host, and that no other host is actively using it, and returns a
+// *HostConflictError otherwise.
+func (c *client) PinHost(ctx context.Context, mac, ip string) (result types.LanInterfaceHost, err error) {
+	address, err := netip.ParseAddr(ip)
+	if err != nil {
+		return result, fmt.Errorf("failed to parse IP %q: %w", ip, err)
+	}
+
+	if err = c.checkDHCPRange(ctx, mac, address); err != nil {
+		return result, err
+	}
+
+	leases, err := c.ListDHCPStaticLease(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to list dhcp static leases: %w", err)
+	}
+
+	var existing *types.DHCPStaticLeaseInfo
+
+	for index, lease := range leases {
+		switch {
+		case strings.EqualFold(lease.Mac, mac):
+			existing = &leases[index]
+		case lease.IP == address.String():
+			return result, &HostConflictError{
+				Reason:          HostConflictReasonStaticLease,
+				Mac:             mac,
+				IP:              ip,
+				ConflictingID:   lease.ID,
+				ConflictingMac:  lease.Mac,
+				ConflictingName: lease.Hostname,
+			}
+		}
+	}
+
+	if existing != nil && existing.IP == address.String() {
+		return existing.Host, nil
+	}
+
+	if err = c.checkActiveHosts(ctx, mac, address); err != nil {
+		return result, err
+	}
+
+	if existing != nil {
+		return c.UpdateDHCPStaticLease(ctx, existing.ID, types.DHCPStaticLeasePayload{IP: address.String()})
+	}
+
+	return c.CreateDHCPStaticLease(ctx, types.DHCPStaticLeasePayload{Mac: mac, IP: address.String()})
+}
+
+func (c *client) checkDHCPRange(ctx context.Context, mac string, address netip.Addr) error {
+	config, err := c.GetDHCPConfiguration(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get dhcp configuration: %w", err)
+	}
+
+	start, err := netip.ParseAddr(config.IPRangeStart)
+	if err != nil {
+		return fmt.Errorf("failed to parse DHCP range start %q: %w", config.IPRangeStart, err)
+	}
+
+	end, err := netip.ParseAddr(config.IPRangeEnd)
+	if err != nil {
+		return fmt.Errorf("failed to parse DHCP range end %q: %w", config.IPRangeEnd, err)
+	}
+
+	if address.Compare(start) < 0 || address.Compare(end) > 0 {
+		return &HostConflictError{
+			Reason:     HostConflictReasonOutsideDHCPRange,
+			Mac:        mac,
+			IP:         address.String(),
+			RangeStart: config.IPRangeStart,
+			RangeEnd:   config.IPRangeEnd,
+		}
+	}
+
+	return nil
+}
+
+func (c *client) checkActiveHosts(ctx context.Context, mac string, address netip.Addr) error {
+	interfaces, err := c.ListLanInterfaceInfo(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list lan interfaces: %w", err)
+	}
+
+	for _, lanInterface := range interfaces {
+		hosts, err := c.GetLanInterface(ctx, lanInterface.Name)
+		if err != nil {
+			return fmt.Errorf("failed to get hosts of lan interface %s: %w", lanInterface.Name, err)
+		}
+
+		for _, host := range hosts {
+			if strings.EqualFold(host.L2Ident.ID, mac) {
+				continue
+			}
+
+			for _, connectivity := range host.L3Connectivities {
+				if connectivity.Type == types.IPV4 && connectivity.Active && connectivity.Address == address.String() {
+					return &HostConflictError{
+						Reason:          HostConflictReasonActiveHost,
+						Mac:             mac,
+						IP:              address.String(),
+						ConflictingID:   host.ID,
+						ConflictingMac:  host.L2Ident.ID,
+						ConflictingName: host.PrimaryName,
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("pinning a host", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		ip           = new(string)
+		returnedHost = new(types.LanInterfaceHost)
+		returnedErr  = new(error)
+	)
+
+	const mac = "00:24:d4:7e:00:4c"
+
+	respondWithDHCPConfig := func() http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dhcp/config/", version)),
+			verifyAuth(*sessionToken),
+			ghttp.RespondWith(http.StatusOK, `{
+				"success": true,
+				"result": {"enabled": true, "ip_range_start": "192.168.1.10", "ip_range_end": "192.168.1.50"}
+			}`),
+		)
+	}
+	respondWithStaticLeases := func(leases string) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dhcp/static_lease/", version)),
+			verifyAuth(*sessionToken),
+			ghttp.RespondWith(http.StatusOK, `{"success": true, "result": `+leases+`}`),
+		)
+	}
+	respondWithHosts := func(hosts string) []http.HandlerFunc {
+		return []http.HandlerFunc{
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/lan/browser/interfaces/", version)),
+				verifyAuth(*sessionToken),
+				ghttp.RespondWith(http.StatusOK, `{"success": true, "result": [{"name": "pub", "host_count": 2}]}`),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/lan/browser/pub", version)),
+				verifyAuth(*sessionToken),
+				ghttp.RespondWith(http.StatusOK, `{"success": true, "result": `+hosts+`}`),
+			),
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+
+		*ip = "192.168.1.20"
+	})
+
+	JustBeforeEach(func() {
+		*returnedHost, *returnedErr = freeboxClient.PinHost(ctx, mac, *ip)
+	})
+
+	Context("default", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				respondWithDHCPConfig(),
+				respondWithStaticLeases(`[{"id": "00:11:22:33:44:55", "mac": "00:11:22:33:44:55", "ip": "192.168.1.21"}]`),
+			)
+			server.AppendHandlers(respondWithHosts(`[
+				{
+					"id": "ether-00:24:d4:7e:00:4c",
+					"l2ident": {"id": "00:24:d4:7e:00:4c", "type": "mac_address"},
+					"l3connectivities": [{"addr": "192.168.1.33", "af": "ipv4", "active": true}]
+				},
+				{
+					"id": "ether-00:11:22:33:44:55",
+					"l2ident": {"id": "00:11:22:33:44:55", "type": "mac_address"},
+					"l3connectivities": [{"addr": "192.168.1.20", "af": "ipv4", "active": false}]
+				}
+			]`)...)
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/dhcp/static_lease/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"mac": "00:24:d4:7e:00:4c", "ip": "192.168.1.20"}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": "ether-00:24:d4:7e:00:4c", "primary_name": "player"}}`),
+				),
+			)
+		})
+		It("should create the static lease", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(returnedHost.PrimaryName).To(Equal("player"))
+		})
+	})
+	Context("when the host already has a static lease on another IP", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				respondWithDHCPConfig(),
+				respondWithStaticLeases(`[{"id": "00:24:d4:7e:00:4c", "mac": "00:24:D4:7E:00:4C", "ip": "192.168.1.33"}]`),
+			)
+			server.AppendHandlers(respondWithHosts(`[]`)...)
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/dhcp/static_lease/00:24:d4:7e:00:4c", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"ip": "192.168.1.20"}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": "ether-00:24:d4:7e:00:4c"}}`),
+				),
+			)
+		})
+		It("should update the existing static lease", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(returnedHost.ID).To(Equal("ether-00:24:d4:7e:00:4c"))
+		})
+	})
+	Context("when the host is already pinned to the IP", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				respondWithDHCPConfig(),
+				respondWithStaticLeases(`[{"id": "00:24:d4:7e:00:4c", "mac": "00:24:d4:7e:00:4c", "ip": "192.168.1.20", "host": {"id": "ether-00:24:d4:7e:00:4c"}}]`),
+			)
+		})
+		It("should return the host without any change", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(returnedHost.ID).To(Equal("ether-00:24:d4:7e:00:4c"))
+			Expect(server.ReceivedRequests()).To(HaveLen(4))
+		})
+	})
+	Context("when the IP is outside of the DHCP range", func() {
+		BeforeEach(func() {
+			*ip = "192.168.1.200"
+			server.AppendHandlers(respondWithDHCPConfig())
+		})
+		It("should return a conflict error", func() {
+			Expect(*returnedErr).To(MatchError(client.ErrHostConflict))
+			conflict := new(client.HostConflictError)
+			Expect(errors.As(*returnedErr, &conflict)).To(BeTrue())
+			Expect(*conflict).To(Equal(client.HostConflictError{
+				Reason:     client.HostConflictReasonOutsideDHCPRange,
+				Mac:        mac,
+				IP:         "192.168.1.200",
+				RangeStart: "192.168.1.10",
+				RangeEnd:   "192.168.1.50",
+			}))
+			Expect(*returnedErr).To(MatchError("host conflict: 192.168.1.200 is outside of the DHCP range 192.168.1.10-192.168.1.50"))
+		})
+	})
+	Context("when the IP is assigned to another host by a static lease", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				respondWithDHCPConfig(),
+				respondWithStaticLeases(`[{"id": "00:11:22:33:44:55", "mac": "00:11:22:33:44:55", "hostname": "nas", "ip": "192.168.1.20"}]`),
+			)
+		})
+		It("should return a conflict error", func() {
+			conflict := new(client.HostConflictError)
+			Expect(errors.As(*returnedErr, &conflict)).To(BeTrue())
+			Expect(*conflict).To(Equal(client.HostConflictError{
+				Reason:          client.HostConflictReasonStaticLease,
+				Mac:             mac,
+				IP:              "192.168.1.20",
+				ConflictingID:   "00:11:22:33:44:55",
+				ConflictingMac:  "00:11:22:33:44:55",
+				ConflictingName: "nas",
+			}))
+		})
+	})
+	Context("when the IP is in use by another active host", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				respondWithDHCPConfig(),
+				respondWithStaticLeases(`[]`),
+			)
+			server.AppendHandlers(respondWithHosts(`[
+				{
+					"id": "ether-00:11:22:33:44:55",
+					"primary_name": "laptop",
+					"l2ident": {"id": "00:11:22:33:44:55", "type": "mac_address"},
+					"l3connectivities": [
+						{"addr": "fe80::1", "af": "ipv6", "active": true},
+						{"addr": "192.168.1.20", "af": "ipv4", "active": true}
+					]
+				}
+			]`)...)
+		})
+		It("should return a conflict error", func() {
+			conflict := new(client.HostConflictError)
+			Expect(errors.As(*returnedErr, &conflict)).To(BeTrue())
+			Expect(conflict.Reason).To(Equal(client.HostConflictReasonActiveHost))
+			Expect(conflict.ConflictingID).To(Equal("ether-00:11:22:33:44:55"))
+			Expect(conflict.ConflictingName).To(Equal("laptop"))
+		})
+	})
+	Context("when the IP is invalid", func() {
+		BeforeEach(func() {
+			*ip = "not an ip"
+		})
+		It("should return an error", func() {
+			Expect(*returnedErr).To(MatchError(ContainSubstring("failed to parse IP")))
+			Expect(*returnedErr).ToNot(MatchError(client.ErrHostConflict))
+		})
+	})
+	Context("when the server fails to respond", func() {
+		BeforeEach(func() {
+			server.Close()
+		})
+		It("should return an error", func() {
+			Expect(*returnedErr).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,289 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("dhcp", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("getting the DHCP configuration", func() {
+		returnedConfig := new(types.DHCPConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetDHCPConfiguration(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dhcp/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"enabled": true,
+								"sticky_assign": true,
+								"gateway": "192.168.1.254",
+								"netmask": "255.255.255.0",
+								"ip_range_start": "192.168.1.1",
+								"ip_range_end": "192.168.1.50",
+								"always_broadcast": false,
+								"dns": ["192.168.1.254", "", "", "", ""]
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.DHCPConfiguration{
+					Enabled:      true,
+					StickyAssign: true,
+					Gateway:      "192.168.1.254",
+					Netmask:      "255.255.255.0",
+					IPRangeStart: "192.168.1.1",
+					IPRangeEnd:   "192.168.1.50",
+					DNS:          []string{"192.168.1.254", "", "", "", ""},
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the DHCP configuration", func() {
+		returnedConfig := new(types.DHCPConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateDHCPConfiguration(ctx, types.DHCPConfiguration{
+				Enabled:      true,
+				IPRangeStart: "192.168.1.10",
+				IPRangeEnd:   "192.168.1.100",
+				DNS:          []string{"1.1.1.1"},
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/dhcp/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"enabled": true,
+							"sticky_assign": false,
+							"gateway": "",
+							"netmask": "",
+							"ip_range_start": "192.168.1.10",
+							"ip_range_end": "192.168.1.100",
+							"always_broadcast": false,
+							"dns": ["1.1.1.1"]
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": true, "ip_range_start": "192.168.1.10", "ip_range_end": "192.168.1.100", "dns": ["1.1.1.1"]}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.IPRangeEnd).To(Equal("192.168.1.100"))
+			})
+		})
+		Context("when the server returns an api error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/dhcp/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": false, "error_code": "inval", "msg": "invalid range"}`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("invalid range")))
+			})
+		})
+	})
+
+	Context("getting the DHCPv6 configuration", func() {
+		returnedConfig := new(types.DHCPv6Configuration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetDHCPv6Configuration(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dhcpv6/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": true, "use_custom_dns": false, "dns": ["", ""]}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.DHCPv6Configuration{Enabled: true, DNS: []string{"", ""}}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the DHCPv6 configuration", func() {
+		returnedConfig := new(types.DHCPv6Configuration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateDHCPv6Configuration(ctx, types.DHCPv6Configuration{
+				Enabled:      true,
+				UseCustomDNS: true,
+				DNS:          []string{"2606:4700:4700::1111"},
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/dhcpv6/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"enabled": true, "use_custom_dns": true, "dns": ["2606:4700:4700::1111"]}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": true, "use_custom_dns": true, "dns": ["2606:4700:4700::1111"]}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.UseCustomDNS).To(BeTrue())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the DHCP dynamic leases", func() {
+		returnedLeases := new([]types.DHCPDynamicLease)
+		JustBeforeEach(func() {
+			*returnedLeases, *returnedErr = freeboxClient.ListDHCPDynamicLeases(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dhcp/dynamic_lease/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"mac": "00:24:d4:7e:00:4c",
+									"hostname": "freebox-player",
+									"ip": "192.168.1.12",
+									"lease_remaining": 37431,
+									"assign_time": 1360225345,
+									"refresh_time": 1360225345,
+									"is_static": false,
+									"host": {"id": "ether-00:24:d4:7e:00:4c", "primary_name": "Freebox Player"}
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct leases", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLeases).To(HaveLen(1))
+				lease := (*returnedLeases)[0]
+				Expect(lease.IP).To(Equal("192.168.1.12"))
+				Expect(lease.LeaseRemaining).To(Equal(int64(37431)))
+				Expect(lease.AssignTime.Time).To(Equal(time.Unix(1360225345, 0).UTC()))
+				Expect(lease.Host.PrimaryName).To(Equal("Freebox Player"))
+				Expect(lease.StaticLeasePayload()).To(Equal(types.DHCPStaticLeasePayload{
+					Mac:      "00:24:d4:7e:00:4c",
+					Hostname: "freebox-player",
+					IP:       "192.168.1.12",
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dhcp/dynamic_lease/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLeases).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
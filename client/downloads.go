@@ -177,3 +177,21 @@ func (c *client) UpdateDownloadTask(ctx context.Context, identifier int64, downl
 
 	return nil
 }
+
+// GetDownloadLog returns the log of a download task.
+func (c *client) GetDownloadLog(ctx context.Context, identifier int64) (result string, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("downloads/%d/log", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return result, ErrTaskNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET downloads/%d/log endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get a download log from generic response: %w", err)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,193 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeTrackerNotFound = "bt_tracker_not_found"
+)
+
+func (c *client) ListDownloadFiles(ctx context.Context, identifier int64) (result []types.DownloadFile, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("downloads/%d/files", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return nil, ErrTaskNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET downloads/%d/files endpoint: %w", identifier, err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to get download files from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// UpdateDownloadFile updates the download priority of a file of a task.
+func (c *client) UpdateDownloadFile(ctx context.Context, identifier int64, fileID string, payload types.DownloadFileUpdate) error {
+	response, err := c.put(ctx, fmt.Sprintf("downloads/%d/files/%s", identifier, fileID), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return ErrTaskNotFound
+		}
+
+		return fmt.Errorf("failed to PUT downloads/%d/files/%s endpoint: %w", identifier, fileID, err)
+	}
+
+	return nil
+}
+
+func (c *client) ListDownloadTrackers(ctx context.Context, identifier int64) (result []types.DownloadTracker, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("downloads/%d/trackers", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return nil, ErrTaskNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET downloads/%d/trackers endpoint: %w", identifier, err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to get download trackers from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) AddDownloadTracker(ctx context.Context, identifier int64, announce string) error {
+	response, err := c.post(ctx, fmt.Sprintf("downloads/%d/trackers", identifier), map[string]interface{}{
+		"announce": announce,
+	}, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return ErrTaskNotFound
+		}
+
+		return fmt.Errorf("failed to POST downloads/%d/trackers endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// UpdateDownloadTracker enables or disables the tracker with the given announce URL.
+func (c *client) UpdateDownloadTracker(ctx context.Context, identifier int64, announce string, payload types.DownloadTrackerUpdate) error {
+	path := fmt.Sprintf("downloads/%d/trackers/%s", identifier, url.PathEscape(announce))
+
+	response, err := c.put(ctx, path, payload, c.withSession(ctx))
+	if err != nil {
+		return downloadTrackerError(response, fmt.Errorf("failed to PUT %s endpoint: %w", path, err))
+	}
+
+	return nil
+}
+
+func (c *client) RemoveDownloadTracker(ctx context.Context, identifier int64, announce string) error {
+	path := fmt.Sprintf("downloads/%d/trackers/%s", identifier, url.PathEscape(announce))
+
+	response, err := c.delete(ctx, path, c.withSession(ctx))
+	if err != nil {
+		return downloadTrackerError(response, fmt.Errorf("failed to DELETE %s endpoint: %w", path, err))
+	}
+
+	return nil
+}
+
+func downloadTrackerError(response *genericResponse, err error) error {
+	if response != nil {
+		switch response.ErrorCode {
+		case codeTaskNotFound:
+			return ErrTaskNotFound
+		case codeTrackerNotFound:
+			return ErrTrackerNotFound
+		}
+	}
+
+	return err
+}
+
+func (c *client) ListDownloadPeers(ctx context.Context, identifier int64) (result []types.DownloadPeer, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("downloads/%d/peers", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return nil, ErrTaskNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET downloads/%d/peers endpoint: %w", identifier, err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to get download peers from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) GetDownloadPieces(ctx context.Context, identifier int64) (result types.DownloadPieces, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("downloads/%d/pieces", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return result, ErrTaskNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET downloads/%d/pieces endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get download pieces from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) ListDownloadBlacklist(ctx context.Context, identifier int64) (result []types.DownloadBlacklistEntry, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("downloads/%d/blacklist", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return nil, ErrTaskNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET downloads/%d/blacklist endpoint: %w", identifier, err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return nil, fmt.Errorf("failed to get download blacklist from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// EmptyDownloadBlacklist removes every host from the blacklist of a task.
+func (c *client) EmptyDownloadBlacklist(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("downloads/%d/blacklist/empty", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeTaskNotFound {
+			return ErrTaskNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE downloads/%d/blacklist/empty endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,674 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("bittorrent downloads", func() {
+	const (
+		taskID   = int64(42)
+		announce = "udp://tracker.example.com:80/announce"
+	)
+
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	// The announce URL is escaped in the request path, which ghttp.VerifyRequest does not check.
+	verifyEscapedTrackerPath := func(method string) http.HandlerFunc {
+		return func(_ http.ResponseWriter, req *http.Request) {
+			Expect(req.Method).To(Equal(method))
+			Expect(req.URL.EscapedPath()).To(Equal("/api/" + version + "/downloads/42/trackers/udp:%2F%2Ftracker.example.com:80%2Fannounce"))
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing the files of a download task", func() {
+		returnedFiles := new([]types.DownloadFile)
+		JustBeforeEach(func() {
+			*returnedFiles, *returnedErr = freeboxClient.ListDownloadFiles(ctx, taskID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/files", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": "42-0",
+									"task_id": 42,
+									"path": "L0ZyZWVib3gvZGViaWFuLmlzbw==",
+									"filepath": "debian.iso",
+									"name": "debian.iso",
+									"mimetype": "application/x-iso9660-image",
+									"size": 661651456,
+									"rx": 330825728,
+									"status": "downloading",
+									"priority": "normal",
+									"error": "none",
+									"preview_url": ""
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct files", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedFiles).To(Equal([]types.DownloadFile{
+					{
+						ID:        "42-0",
+						TaskID:    taskID,
+						Path:      "/Freebox/debian.iso",
+						FilePath:  "debian.iso",
+						Name:      "debian.iso",
+						MimeType:  "application/x-iso9660-image",
+						SizeBytes: 661651456,
+						RxBytes:   330825728,
+						Status:    types.DownloadFileStatusDownloading,
+						Priority:  types.DownloadFilePriorityNormal,
+						Error:     types.DownloadTaskErrorNone,
+					},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/files", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedFiles).To(BeEmpty())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/files", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a file of a download task", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.UpdateDownloadFile(ctx, taskID, "42-0", types.DownloadFileUpdate{
+				Priority: types.DownloadFilePriorityNoDownload,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/downloads/42/files/42-0", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"priority": "no_dl"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/downloads/42/files/42-0", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the trackers of a download task", func() {
+		returnedTrackers := new([]types.DownloadTracker)
+		JustBeforeEach(func() {
+			*returnedTrackers, *returnedErr = freeboxClient.ListDownloadTrackers(ctx, taskID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/trackers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"announce": "udp://tracker.example.com:80/announce",
+									"is_backup": false,
+									"status": "announce_ok",
+									"interval": 1800,
+									"min_interval": 900,
+									"reannounce_in": 1200,
+									"nseeders": 12,
+									"nleechers": 3,
+									"is_enabled": true
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct trackers", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedTrackers).To(Equal([]types.DownloadTracker{
+					{
+						Announce:            announce,
+						Status:              types.DownloadTrackerStatusAnnounceOK,
+						IntervalSeconds:     1800,
+						MinIntervalSeconds:  900,
+						ReannounceInSeconds: 1200,
+						Seeders:             12,
+						Leechers:            3,
+						Enabled:             true,
+					},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/trackers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedTrackers).To(BeEmpty())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/trackers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("adding a tracker to a download task", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.AddDownloadTracker(ctx, taskID, announce)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/downloads/42/trackers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"announce": "udp://tracker.example.com:80/announce"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/downloads/42/trackers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a tracker of a download task", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.UpdateDownloadTracker(ctx, taskID, announce, types.DownloadTrackerUpdate{Enabled: false})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						verifyEscapedTrackerPath(http.MethodPut),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"is_enabled": false}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the tracker is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						verifyEscapedTrackerPath(http.MethodPut),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "bt_tracker_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTrackerNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTrackerNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("removing a tracker from a download task", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.RemoveDownloadTracker(ctx, taskID, announce)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						verifyEscapedTrackerPath(http.MethodDelete),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						verifyEscapedTrackerPath(http.MethodDelete),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the tracker is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						verifyEscapedTrackerPath(http.MethodDelete),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "bt_tracker_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTrackerNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTrackerNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the peers of a download task", func() {
+		returnedPeers := new([]types.DownloadPeer)
+		JustBeforeEach(func() {
+			*returnedPeers, *returnedErr = freeboxClient.ListDownloadPeers(ctx, taskID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/peers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"host": "203.0.113.7",
+									"port": 51413,
+									"state": "connected",
+									"origin": "tracker",
+									"protocol": "tcp",
+									"client": "Transmission 4.0.5",
+									"country": "FR",
+									"tx_bytes": 1024,
+									"rx_bytes": 2048,
+									"tx_rate": 10,
+									"rx_rate": 20,
+									"progress": 0.5,
+									"requested": true,
+									"local_interested": true,
+									"local_choked": false,
+									"peer_interested": false,
+									"peer_choked": true
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct peers", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPeers).To(Equal([]types.DownloadPeer{
+					{
+						Host:            "203.0.113.7",
+						Port:            51413,
+						State:           "connected",
+						Origin:          "tracker",
+						Protocol:        "tcp",
+						Client:          "Transmission 4.0.5",
+						Country:         "FR",
+						TxBytes:         1024,
+						RxBytes:         2048,
+						TxRate:          10,
+						RxRate:          20,
+						Progress:        0.5,
+						Requested:       true,
+						LocalInterested: true,
+						PeerChoked:      true,
+					},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/peers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPeers).To(BeEmpty())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/peers", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting the pieces of a download task", func() {
+		returnedPieces := new(types.DownloadPieces)
+		JustBeforeEach(func() {
+			*returnedPieces, *returnedErr = freeboxClient.GetDownloadPieces(ctx, taskID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/pieces", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": "XXX/..UU"}`),
+					),
+				)
+			})
+			It("should return the pieces bitmap", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPieces).To(Equal(types.DownloadPieces("XXX/..UU")))
+				Expect(returnedPieces.Count(types.DownloadPieceStateDone)).To(Equal(3))
+				Expect(returnedPieces.Count(types.DownloadPieceStateDownloading)).To(Equal(1))
+				Expect(returnedPieces.Count(types.DownloadPieceStateMissing)).To(Equal(2))
+				Expect(returnedPieces.Count(types.DownloadPieceStateUnwanted)).To(Equal(2))
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/pieces", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the blacklist of a download task", func() {
+		returnedEntries := new([]types.DownloadBlacklistEntry)
+		JustBeforeEach(func() {
+			*returnedEntries, *returnedErr = freeboxClient.ListDownloadBlacklist(ctx, taskID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/blacklist", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [{"host": "198.51.100.3", "expire": 3600}]
+						}`),
+					),
+				)
+			})
+			It("should return the correct entries", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedEntries).To(Equal([]types.DownloadBlacklistEntry{
+					{Host: "198.51.100.3", Expire: 3600},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/blacklist", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedEntries).To(BeEmpty())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/blacklist", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("emptying the blacklist of a download task", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.EmptyDownloadBlacklist(ctx, taskID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/downloads/42/blacklist/empty", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/downloads/42/blacklist/empty", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
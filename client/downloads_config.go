@@ -2,6 +2,7 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/nikolalohinski/free-go/types"
@@ -20,7 +21,22 @@ func (c *client) GetDownloadConfiguration(ctx context.Context) (result types.Dow
 	return result, nil
 }
 
+// UpdateDownloadConfiguration updates the download configuration. The bittorrent ports, if set, are checked against the
+// range of ports usable on the public IPv4 address before sending the request, unless the connection status can not be read.
 func (c *client) UpdateDownloadConfiguration(ctx context.Context, payload types.DownloadConfiguration) (result types.DownloadConfiguration, err error) {
+	ports := make([]int64, 0, 2)
+	for _, port := range []int{payload.Bt.MainPort, payload.Bt.DHTPort} {
+		if port != 0 {
+			ports = append(ports, int64(port))
+		}
+	}
+
+	if len(ports) > 0 {
+		if err = c.checkPortsInRange(ctx, ports...); errors.Is(err, ErrPortOutsideRange) {
+			return result, err
+		}
+	}
+
 	response, err := c.put(ctx, "downloads/config/", payload, c.withSession(ctx))
 	if err != nil {
 		return result, fmt.Errorf("failed to PUT downloads/config/ endpoint: %w", err)
@@ -244,6 +244,64 @@ var _ = Describe("downloads config", func() {
 				Expect(*returnedErr).ToNot(BeNil())
 			})
 		})
+		Context("when the bittorrent ports are within the ipv4 port range", func() {
+			BeforeEach(func() {
+				payload.Bt = types.DlBtConfig{MainPort: 16881, DHTPort: 16882}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"ipv4_port_range": [16384, 32767]}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/downloads/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"bt": {"main_port": 16881, "dht_port": 16882}}}`),
+					),
+				)
+			})
+			It("should update the download configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.Bt.MainPort).To(Equal(16881))
+			})
+		})
+		Context("when a bittorrent port is outside of the ipv4 port range", func() {
+			BeforeEach(func() {
+				payload.Bt = types.DlBtConfig{MainPort: 16881, DHTPort: 6881}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"ipv4_port_range": [16384, 32767]}}`),
+					),
+				)
+			})
+			It("should return ErrPortOutsideRange without sending the update", func() {
+				Expect(*returnedErr).To(MatchError(client.ErrPortOutsideRange))
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+		Context("when the connection status can not be read", func() {
+			BeforeEach(func() {
+				payload.Bt = types.DlBtConfig{MainPort: 16881, DHTPort: 16882}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "insufficient_rights"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/downloads/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"bt": {"main_port": 16881, "dht_port": 16882}}}`),
+					),
+				)
+			})
+			It("should update the download configuration without checking the ports", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.Bt.MainPort).To(Equal(16881))
+			})
+		})
 		Context("when the server returns an api error", func() {
 			BeforeEach(func() {
 				server.AppendHandlers(
@@ -252,8 +310,8 @@ var _ = Describe("downloads config", func() {
 						verifyAuth(*sessionToken),
 						ghttp.RespondWith(http.StatusOK, `{
 							"success": false,
-							"error_code": "auth_required",
-							"msg": "not logged in"
+							"error_code": "internal_error",
+							"msg": "internal error"
 						}`),
 					),
 				)
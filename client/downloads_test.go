@@ -611,4 +611,50 @@ var _ = Describe("downloads", func() {
 			})
 		})
 	})
+	Context("getting a download log", func() {
+		returnedLog := new(string)
+		JustBeforeEach(func() {
+			*returnedLog, *returnedErr = freeboxClient.GetDownloadLog(context.Background(), 42)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/log", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": "2024-03-01 10:00:00 task started\n2024-03-01 10:05:00 task done\n"
+						}`),
+					),
+				)
+			})
+			It("should return the log", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLog).To(Equal("2024-03-01 10:00:00 task started\n2024-03-01 10:05:00 task done\n"))
+			})
+		})
+		Context("when the task is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/downloads/42/log", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "task_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrTaskNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrTaskNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
 })
@@ -121,6 +121,8 @@ var _ = Describe("events", func() {
 					Name:   "bar",
 				},
 			}
+			// The handler may outlive the spec, capture the cancel function of this spec only
+			cancel := cancelContext
 			server.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {
 				Expect(r.Header[client.AuthHeader]).To(ContainElement(Equal(*sessionToken)))
 				ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
@@ -143,7 +145,7 @@ var _ = Describe("events", func() {
 					"success": true
 				}`))).To(BeNil())
 
-				cancelContext()
+				cancel()
 
 				_, _, err = ws.ReadMessage()
 				Expect(websocket.IsCloseError(err, websocket.CloseNormalClosure)).To(BeTrue(), fmt.Sprintf("websocket should have been closed by client, got: %v", err))
@@ -226,6 +228,44 @@ var _ = Describe("events", func() {
 			Expect(*returnedErr).ToNot(BeNil())
 		})
 	})
+	Context("when the websocket handshake is rejected because of the session", func() {
+		const renewedToken = "renewed-session-token"
+		BeforeEach(func() {
+			*events = []types.EventDescription{
+				{
+					Source: "foo",
+					Name:   "bar",
+				},
+			}
+			server.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header[client.AuthHeader]).To(ContainElement(Equal(*sessionToken)))
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"success": false, "error_code": "auth_required"}`))
+			})
+			setupLoginFlowWithToken(server, renewedToken)
+			server.AppendHandlers(func(w http.ResponseWriter, r *http.Request) {
+				Expect(r.Header[client.AuthHeader]).To(ContainElement(Equal(renewedToken)))
+				ws, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+				if err != nil {
+					Expect(err).To(BeNil())
+				}
+				defer ws.Close()
+
+				_, _, err = ws.ReadMessage()
+				Expect(err).To(BeNil())
+				Expect(ws.WriteMessage(websocket.TextMessage, []byte(`{
+					"action": "register",
+					"success": true
+				}`))).To(BeNil())
+			})
+		})
+		It("should login again and dial the websocket with the new session", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(server.ReceivedRequests()).To(HaveLen(6))
+			cancelContext()
+		})
+	})
 	Context("when registering for notifications fails", func() {
 		BeforeEach(func() {
 			*events = []types.EventDescription{
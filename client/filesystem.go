@@ -4,9 +4,7 @@ import (
 	"bufio"
 	"context"
 	"encoding/base64"
-	"errors"
 	"fmt"
-	"io"
 	"mime"
 	"net/http"
 	"strings"
@@ -247,28 +245,23 @@ func (c *client) GetFile(ctx context.Context, path string) (result types.File, e
 		return result, fmt.Errorf("failed to apply option to request: %w", err)
 	}
 
-	httpResponse, err := c.httpClient.Do(request)
+	httpResponse, err := c.stream(request)
 	if err != nil {
-		return result, fmt.Errorf("failed to perform request: %w", err)
+		return result, err
 	}
 
-	if httpResponse.StatusCode != http.StatusOK {
-		content, err := io.ReadAll(httpResponse.Body)
-		if err != nil {
-			return result, errors.Join(
-				fmt.Errorf("failed with status '%d'", httpResponse.StatusCode),
-				fmt.Errorf("failed to read response body: %w", err),
-			)
-		}
-
-		return result, fmt.Errorf("failed with status '%d': server returned '%s'", httpResponse.StatusCode, content)
-	}
+	return fileFromHTTPResponse(httpResponse)
+}
 
+// fileFromHTTPResponse wraps the body of a raw download into a file, closing it when its headers can not be parsed.
+func fileFromHTTPResponse(httpResponse *http.Response) (types.File, error) {
 	mediatype := ""
 	if contentType := httpResponse.Header.Get("Content-Type"); contentType != "" {
-		mediatype, _, err = mime.ParseMediaType(contentType)
-		if err != nil {
-			return result, fmt.Errorf("failed to parse media type: %w", err)
+		var err error
+		if mediatype, _, err = mime.ParseMediaType(contentType); err != nil {
+			httpResponse.Body.Close()
+
+			return types.File{}, fmt.Errorf("failed to parse media type: %w", err)
 		}
 	}
 
@@ -277,7 +270,9 @@ func (c *client) GetFile(ctx context.Context, path string) (result types.File, e
 	if contentDisposition := httpResponse.Header.Get("Content-Disposition"); contentDisposition != "" {
 		_, params, err := mime.ParseMediaType(contentDisposition)
 		if err != nil {
-			return result, fmt.Errorf("failed to parse media type: %w", err)
+			httpResponse.Body.Close()
+
+			return types.File{}, fmt.Errorf("failed to parse media type: %w", err)
 		}
 
 		filename = params["filename"]
@@ -309,3 +304,83 @@ func (c *client) ExtractFile(ctx context.Context, payload types.ExtractFilePaylo
 
 	return result, nil
 }
+
+func (c *client) ConcatenateFiles(ctx context.Context, payload types.ConcatenateFilesPayload) (task types.FileSystemTask, err error) {
+	response, err := c.post(ctx, "fs/cat/", payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == destinationConflictCode {
+			return task, ErrDestinationConflict
+		}
+
+		return task, fmt.Errorf("failed to POST to fs/cat/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &task); err != nil {
+		return task, fmt.Errorf("failed to get a filesystem task from a generic response: %w", err)
+	}
+
+	return task, nil
+}
+
+func (c *client) CreateArchive(ctx context.Context, payload types.CreateArchivePayload) (task types.FileSystemTask, err error) {
+	response, err := c.post(ctx, "fs/archive/", payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == destinationConflictCode {
+			return task, ErrDestinationConflict
+		}
+
+		return task, fmt.Errorf("failed to POST to fs/archive/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &task); err != nil {
+		return task, fmt.Errorf("failed to get a filesystem task from a generic response: %w", err)
+	}
+
+	return task, nil
+}
+
+// RepairFile checks and repairs the files of a set using the given .par2 file.
+func (c *client) RepairFile(ctx context.Context, path string) (task types.FileSystemTask, err error) {
+	response, err := c.post(ctx, "fs/repair/", map[string]interface{}{
+		"src": types.Base64Path(path),
+	}, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == pathNotFoundCode {
+			return task, ErrPathNotFound
+		}
+
+		return task, fmt.Errorf("failed to POST to fs/repair/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &task); err != nil {
+		return task, fmt.Errorf("failed to get a filesystem task from a generic response: %w", err)
+	}
+
+	return task, nil
+}
+
+// RenameFile renames the file or directory at path to the given name, within the same directory.
+func (c *client) RenameFile(ctx context.Context, path, name string) (task types.FileSystemTask, err error) {
+	response, err := c.post(ctx, "fs/rename/", map[string]interface{}{
+		"src": types.Base64Path(path),
+		"dst": name,
+	}, c.withSession(ctx))
+	if err != nil {
+		if response != nil {
+			switch response.ErrorCode {
+			case pathNotFoundCode:
+				return task, ErrPathNotFound
+			case destinationConflictCode:
+				return task, ErrDestinationConflict
+			}
+		}
+
+		return task, fmt.Errorf("failed to POST to fs/rename/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &task); err != nil {
+		return task, fmt.Errorf("failed to get a filesystem task from a generic response: %w", err)
+	}
+
+	return task, nil
+}
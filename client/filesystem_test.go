@@ -1245,6 +1245,291 @@ var _ = Describe("filesystem", func() {
 				)
 			})
 
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+	Context("ConcatenateFiles", func() {
+		returnedTask := new(types.FileSystemTask)
+
+		JustBeforeEach(func(ctx SpecContext) {
+			*returnedTask, *returnedErr = freeboxClient.ConcatenateFiles(ctx, types.ConcatenateFilesPayload{
+				Files:        []types.Base64Path{"/Disque dur/film.mkv.001", "/Disque dur/film.mkv.002"},
+				Dst:          "/Disque dur/film.mkv",
+				MultiVolumes: true,
+				DeleteFiles:  true,
+			})
+		})
+
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/cat/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"files": ["L0Rpc3F1ZSBkdXIvZmlsbS5ta3YuMDAx", "L0Rpc3F1ZSBkdXIvZmlsbS5ta3YuMDAy"],
+							"dst": "L0Rpc3F1ZSBkdXIvZmlsbS5ta3Y=",
+							"multi_volumes": true,
+							"delete_files": true,
+							"overwrite": false,
+							"append": false
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 49,
+								"type": "cat",
+								"state": "queued",
+								"error": "none",
+								"created_ts": 1355842252
+							}
+						}`),
+					),
+				)
+			})
+
+			It("should return the task", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedTask).To(Equal(types.FileSystemTask{
+					ID:               49,
+					Type:             types.FileTaskTypeConcatenate,
+					State:            types.FileTaskStateQueued,
+					Error:            "none",
+					CreatedTimestamp: 1355842252,
+				}))
+			})
+		})
+
+		Context("when the server returns destination_conflict", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/cat/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusConflict, `{"success": false, "error_code": "destination_conflict"}`),
+					),
+				)
+			})
+
+			It("should return ErrDestinationConflict", func() {
+				Expect(*returnedErr).To(Equal(client.ErrDestinationConflict))
+			})
+		})
+
+		Context("when server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("CreateArchive", func() {
+		returnedTask := new(types.FileSystemTask)
+
+		JustBeforeEach(func(ctx SpecContext) {
+			*returnedTask, *returnedErr = freeboxClient.CreateArchive(ctx, types.CreateArchivePayload{
+				Files: []types.Base64Path{"/Disque dur/Photos"},
+				Dst:   "/Disque dur/Photos.zip",
+			})
+		})
+
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/archive/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"files": ["L0Rpc3F1ZSBkdXIvUGhvdG9z"],
+							"dst": "L0Rpc3F1ZSBkdXIvUGhvdG9zLnppcA=="
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 49,
+								"type": "archive",
+								"state": "queued",
+								"error": "none",
+								"created_ts": 1355842252
+							}
+						}`),
+					),
+				)
+			})
+
+			It("should return the task", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedTask).To(Equal(types.FileSystemTask{
+					ID:               49,
+					Type:             types.FileTaskTypeArchive,
+					State:            types.FileTaskStateQueued,
+					Error:            "none",
+					CreatedTimestamp: 1355842252,
+				}))
+			})
+		})
+
+		Context("when the server returns destination_conflict", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/archive/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusConflict, `{"success": false, "error_code": "destination_conflict"}`),
+					),
+				)
+			})
+
+			It("should return ErrDestinationConflict", func() {
+				Expect(*returnedErr).To(Equal(client.ErrDestinationConflict))
+			})
+		})
+
+		Context("when server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("RepairFile", func() {
+		returnedTask := new(types.FileSystemTask)
+
+		JustBeforeEach(func(ctx SpecContext) {
+			*returnedTask, *returnedErr = freeboxClient.RepairFile(ctx, "/Disque dur/film.par2")
+		})
+
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/repair/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"src": "L0Rpc3F1ZSBkdXIvZmlsbS5wYXIy"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 49,
+								"type": "repair",
+								"state": "queued",
+								"error": "none",
+								"created_ts": 1355842252
+							}
+						}`),
+					),
+				)
+			})
+
+			It("should return the task", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedTask).To(Equal(types.FileSystemTask{
+					ID:               49,
+					Type:             types.FileTaskTypeRepair,
+					State:            types.FileTaskStateQueued,
+					Error:            "none",
+					CreatedTimestamp: 1355842252,
+				}))
+			})
+		})
+
+		Context("when the server returns path_not_found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/repair/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusConflict, `{"success": false, "error_code": "path_not_found"}`),
+					),
+				)
+			})
+
+			It("should return ErrPathNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrPathNotFound))
+			})
+		})
+
+		Context("when server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("RenameFile", func() {
+		returnedTask := new(types.FileSystemTask)
+
+		JustBeforeEach(func(ctx SpecContext) {
+			*returnedTask, *returnedErr = freeboxClient.RenameFile(ctx, "/Disque dur/Photos", "Vacances")
+		})
+
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/rename/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"src": "L0Rpc3F1ZSBkdXIvUGhvdG9z", "dst": "Vacances"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 49,
+								"type": "mv",
+								"state": "queued",
+								"error": "none",
+								"created_ts": 1355842252
+							}
+						}`),
+					),
+				)
+			})
+
+			It("should return the task", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedTask).To(Equal(types.FileSystemTask{
+					ID:               49,
+					Type:             types.FileTaskTypeMove,
+					State:            types.FileTaskStateQueued,
+					Error:            "none",
+					CreatedTimestamp: 1355842252,
+				}))
+			})
+		})
+
+		Context("when the server returns destination_conflict", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/fs/rename/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusConflict, `{"success": false, "error_code": "destination_conflict"}`),
+					),
+				)
+			})
+
+			It("should return ErrDestinationConflict", func() {
+				Expect(*returnedErr).To(Equal(client.ErrDestinationConflict))
+			})
+		})
+
+		Context("when server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+
 			It("should return an error", func() {
 				Expect(*returnedErr).ToNot(BeNil())
 			})
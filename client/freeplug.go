@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeFreeplugNotFound = "noent"
+)
+
+func (c *client) ListFreeplugNetworks(ctx context.Context) (result []types.FreeplugNetwork, err error) {
+	response, err := c.get(ctx, "freeplug/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET freeplug/ endpoint: %w", err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to list freeplug networks from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) GetFreeplug(ctx context.Context, identifier string) (result types.Freeplug, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("freeplug/%s/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeFreeplugNotFound {
+			return result, ErrFreeplugNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET freeplug/%s/ endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get freeplug from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) ResetFreeplug(ctx context.Context, identifier string) error {
+	response, err := c.post(ctx, fmt.Sprintf("freeplug/%s/reset/", identifier), nil, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeFreeplugNotFound {
+			return ErrFreeplugNotFound
+		}
+
+		return fmt.Errorf("failed to POST freeplug/%s/reset/ endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// GetFreeplugGraph returns the Freeplug networks flattened into a graph of powerline links.
+func (c *client) GetFreeplugGraph(ctx context.Context) (types.FreeplugGraph, error) {
+	networks, err := c.ListFreeplugNetworks(ctx)
+	if err != nil {
+		return types.FreeplugGraph{}, err
+	}
+
+	return types.NewFreeplugGraph(networks), nil
+}
@@ -0,0 +1,268 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("freeplug", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	const networks = `{
+		"success": true,
+		"result": [
+			{
+				"id": "F4:CA:E5:1D:46:AE",
+				"members": [
+					{
+						"id": "F4:CA:E5:1D:46:AE",
+						"local": true,
+						"net_role": "cco",
+						"eth_port_status": "up",
+						"eth_full_duplex": true,
+						"net_id": "F4:CA:E5:1D:46:AE",
+						"inactive": 0,
+						"eth_speed": 1000,
+						"model": "FBX-FP-R2",
+						"has_network": true,
+						"rx_rate": -1,
+						"tx_rate": -1
+					},
+					{
+						"id": "14:0C:76:8A:3B:21",
+						"local": false,
+						"net_role": "sta",
+						"eth_port_status": "up",
+						"eth_full_duplex": true,
+						"net_id": "F4:CA:E5:1D:46:AE",
+						"inactive": 10,
+						"eth_speed": 100,
+						"model": "FBX-FP-R2",
+						"has_network": true,
+						"rx_rate": 158,
+						"tx_rate": 124
+					}
+				]
+			}
+		]
+	}`
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing freeplug networks", func() {
+		returnedNetworks := new([]types.FreeplugNetwork)
+		JustBeforeEach(func() {
+			*returnedNetworks, *returnedErr = freeboxClient.ListFreeplugNetworks(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/freeplug/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, networks),
+					),
+				)
+			})
+			It("should return the correct networks", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNetworks).To(HaveLen(1))
+				Expect((*returnedNetworks)[0].Members).To(HaveLen(2))
+				Expect((*returnedNetworks)[0].Members[1]).To(Equal(types.Freeplug{
+					ID:            "14:0C:76:8A:3B:21",
+					NetRole:       types.FreeplugNetRoleStation,
+					NetID:         "F4:CA:E5:1D:46:AE",
+					Model:         "FBX-FP-R2",
+					HasNetwork:    true,
+					Inactive:      10,
+					EthPortStatus: types.FreeplugEthPortStatusUp,
+					EthFullDuplex: true,
+					EthSpeed:      100,
+					TxRate:        124,
+					RxRate:        158,
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/freeplug/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNetworks).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting the freeplug graph", func() {
+		returnedGraph := new(types.FreeplugGraph)
+		JustBeforeEach(func() {
+			*returnedGraph, *returnedErr = freeboxClient.GetFreeplugGraph(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/freeplug/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, networks),
+					),
+				)
+			})
+			It("should return the links of the networks", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedGraph.Nodes).To(HaveLen(2))
+				Expect(returnedGraph.Links).To(Equal([]types.FreeplugLink{{
+					NetworkID: "F4:CA:E5:1D:46:AE",
+					From:      "14:0C:76:8A:3B:21",
+					To:        "F4:CA:E5:1D:46:AE",
+					TxRate:    124,
+					RxRate:    158,
+				}}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a freeplug", func() {
+		returnedFreeplug := new(types.Freeplug)
+		JustBeforeEach(func() {
+			*returnedFreeplug, *returnedErr = freeboxClient.GetFreeplug(ctx, "14:0C:76:8A:3B:21")
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/freeplug/14:0C:76:8A:3B:21/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": "14:0C:76:8A:3B:21", "net_role": "sta", "rx_rate": 158, "tx_rate": 124}
+						}`),
+					),
+				)
+			})
+			It("should return the correct freeplug", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFreeplug.TxRate).To(Equal(int64(124)))
+			})
+		})
+		Context("when the freeplug is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/freeplug/14:0C:76:8A:3B:21/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrFreeplugNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrFreeplugNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("resetting a freeplug", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.ResetFreeplug(ctx, "14:0C:76:8A:3B:21")
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/freeplug/14:0C:76:8A:3B:21/reset/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the freeplug is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/freeplug/14:0C:76:8A:3B:21/reset/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrFreeplugNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrFreeplugNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,212 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeHomeNotFound = "noent"
+)
+
+// ListHomeNodes returns every home automation node.
+func (c *client) ListHomeNodes(ctx context.Context) ([]types.HomeNode, error) {
+	response, err := c.get(ctx, "home/nodes", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET home/nodes endpoint: %w", err)
+	}
+
+	result := make([]types.HomeNode, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get home nodes from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetHomeNode returns the home automation node with the given identifier.
+func (c *client) GetHomeNode(ctx context.Context, identifier int64) (node types.HomeNode, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("home/nodes/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return node, ErrHomeNodeNotFound
+		}
+
+		return node, fmt.Errorf("failed to GET home/nodes/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &node); err != nil {
+		return node, fmt.Errorf("failed to get home node from generic response: %w", err)
+	}
+
+	return node, nil
+}
+
+// UpdateHomeNode updates the home automation node with the given identifier.
+func (c *client) UpdateHomeNode(ctx context.Context, identifier int64, payload types.HomeNodePayload) (node types.HomeNode, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("home/nodes/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return node, ErrHomeNodeNotFound
+		}
+
+		return node, fmt.Errorf("failed to PUT home/nodes/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &node); err != nil {
+		return node, fmt.Errorf("failed to get updated home node from generic response: %w", err)
+	}
+
+	return node, nil
+}
+
+// DeleteHomeNode deletes the home automation node with the given identifier.
+func (c *client) DeleteHomeNode(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("home/nodes/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return ErrHomeNodeNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE home/nodes/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// ListHomeAdapters returns every home automation adapter.
+func (c *client) ListHomeAdapters(ctx context.Context) ([]types.HomeAdapter, error) {
+	response, err := c.get(ctx, "home/adapters", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET home/adapters endpoint: %w", err)
+	}
+
+	result := make([]types.HomeAdapter, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get home adapters from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetHomeAdapter returns the home automation adapter with the given identifier.
+func (c *client) GetHomeAdapter(ctx context.Context, identifier int64) (adapter types.HomeAdapter, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("home/adapters/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return adapter, ErrHomeAdapterNotFound
+		}
+
+		return adapter, fmt.Errorf("failed to GET home/adapters/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &adapter); err != nil {
+		return adapter, fmt.Errorf("failed to get home adapter from generic response: %w", err)
+	}
+
+	return adapter, nil
+}
+
+// GetHomeEndpointValue returns the current value of an endpoint of the given node.
+func (c *client) GetHomeEndpointValue(ctx context.Context, nodeID, endpointID int64) (value types.HomeEndpointValue, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("home/endpoints/%d/%d", nodeID, endpointID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return value, ErrHomeEndpointNotFound
+		}
+
+		return value, fmt.Errorf("failed to GET home/endpoints/%d/%d endpoint: %w", nodeID, endpointID, err)
+	}
+
+	if err = c.fromGenericResponse(response, &value); err != nil {
+		return value, fmt.Errorf("failed to get home endpoint value from generic response: %w", err)
+	}
+
+	return value, nil
+}
+
+// SetHomeEndpointValue sets the value of an endpoint of the given node.
+func (c *client) SetHomeEndpointValue(ctx context.Context, nodeID, endpointID int64, payload types.HomeEndpointValuePayload) error {
+	response, err := c.put(ctx, fmt.Sprintf("home/endpoints/%d/%d", nodeID, endpointID), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return ErrHomeEndpointNotFound
+		}
+
+		return fmt.Errorf("failed to PUT home/endpoints/%d/%d endpoint: %w", nodeID, endpointID, err)
+	}
+
+	return nil
+}
+
+// GetHomeEndpointValues returns the current values of several endpoints in a single request.
+func (c *client) GetHomeEndpointValues(ctx context.Context, endpoints []types.HomeEndpointReference) ([]types.HomeEndpointValue, error) {
+	response, err := c.post(ctx, "home/endpoints/get", endpoints, c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to POST home/endpoints/get endpoint: %w", err)
+	}
+
+	result := make([]types.HomeEndpointValue, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get home endpoint values from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// ListHomeLinks returns every home automation link.
+func (c *client) ListHomeLinks(ctx context.Context) ([]types.HomeLink, error) {
+	response, err := c.get(ctx, "home/links", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET home/links endpoint: %w", err)
+	}
+
+	result := make([]types.HomeLink, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get home links from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetHomeLink returns the home automation link with the given identifier.
+func (c *client) GetHomeLink(ctx context.Context, identifier int64) (link types.HomeLink, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("home/links/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return link, ErrHomeLinkNotFound
+		}
+
+		return link, fmt.Errorf("failed to GET home/links/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &link); err != nil {
+		return link, fmt.Errorf("failed to get home link from generic response: %w", err)
+	}
+
+	return link, nil
+}
+
+// GetHomeSecurityModule returns the alarm security module.
+func (c *client) GetHomeSecurityModule(ctx context.Context) (module types.HomeSecurityModule, err error) {
+	response, err := c.get(ctx, "home/secmod", c.withSession(ctx))
+	if err != nil {
+		return module, fmt.Errorf("failed to GET home/secmod endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &module); err != nil {
+		return module, fmt.Errorf("failed to get home security module from generic response: %w", err)
+	}
+
+	return module, nil
+}
@@ -0,0 +1,241 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+type homePairingAction struct {
+	Start bool                      `json:"start,omitempty"`
+	Stop  bool                      `json:"stop,omitempty"`
+	Next  *types.HomePairingAdvance `json:"next,omitempty"`
+}
+
+// GetHomePairingState returns the state of the pairing session of the given adapter.
+func (c *client) GetHomePairingState(ctx context.Context, adapterID int64) (state types.HomePairingState, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("home/pairing/%d", adapterID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return state, ErrHomeAdapterNotFound
+		}
+
+		return state, fmt.Errorf("failed to GET home/pairing/%d endpoint: %w", adapterID, err)
+	}
+
+	if err = c.fromGenericResponse(response, &state); err != nil {
+		return state, fmt.Errorf("failed to get home pairing state from generic response: %w", err)
+	}
+
+	return state, nil
+}
+
+// StartHomePairing starts a pairing session on the given adapter.
+func (c *client) StartHomePairing(ctx context.Context, adapterID int64) error {
+	return c.postHomePairingAction(ctx, adapterID, homePairingAction{Start: true})
+}
+
+// AdvanceHomePairing submits the current page of the pairing session of the given adapter.
+func (c *client) AdvanceHomePairing(ctx context.Context, adapterID int64, payload types.HomePairingAdvance) error {
+	return c.postHomePairingAction(ctx, adapterID, homePairingAction{Next: &payload})
+}
+
+// StopHomePairing stops the pairing session of the given adapter.
+func (c *client) StopHomePairing(ctx context.Context, adapterID int64) error {
+	return c.postHomePairingAction(ctx, adapterID, homePairingAction{Stop: true})
+}
+
+func (c *client) postHomePairingAction(ctx context.Context, adapterID int64, action homePairingAction) error {
+	response, err := c.post(ctx, fmt.Sprintf("home/pairing/%d", adapterID), action, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeHomeNotFound {
+			return ErrHomeAdapterNotFound
+		}
+
+		return fmt.Errorf("failed to POST home/pairing/%d endpoint: %w", adapterID, err)
+	}
+
+	return nil
+}
+
+// PairHomeDevice starts a pairing session on the given adapter and drives it
+// until the device is paired, the pairing fails or ends on the box, or the
+// context is cancelled.
+// Every state change is reported on the returned channel, which is closed once
+// the session is over. Cancelling the context stops the pairing on the box.
+func (c *client) PairHomeDevice(ctx context.Context, adapterID int64, options types.HomePairingOptions) (chan types.HomePairingEvent, error) {
+	if err := c.StartHomePairing(ctx, adapterID); err != nil {
+		return nil, fmt.Errorf("failed to start pairing: %w", err)
+	}
+
+	pollInterval := options.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = HomePairingPollInterval
+	}
+
+	channel := make(chan types.HomePairingEvent, 10)
+
+	go func() {
+		var finalErr error
+
+		defer func() {
+			if finalErr != nil {
+				// The session may still be running on the box, stop it with a fresh
+				// context since the given one might be the reason we are exiting.
+				stopContext, cancel := context.WithTimeout(context.WithoutCancel(ctx), HomePairingStopTimeout)
+				if err := c.StopHomePairing(stopContext, adapterID); err != nil {
+					finalErr = errors.Join(finalErr, fmt.Errorf("failed to stop pairing: %w", err))
+				}
+				cancel()
+
+				channel <- types.HomePairingEvent{
+					Error: fmt.Errorf("encountered error while pairing: %w", finalErr),
+				}
+			}
+
+			close(channel)
+		}()
+
+		var (
+			previous     *types.HomePairingState
+			advanced     *types.HomePairingState
+			acknowledged bool
+		)
+
+		for {
+			select {
+			case <-ctx.Done():
+				finalErr = fmt.Errorf("context was canceled: %w", ctx.Err())
+
+				return
+			case <-time.After(pollInterval):
+			}
+
+			state, err := c.GetHomePairingState(ctx, adapterID)
+			if err != nil {
+				finalErr = fmt.Errorf("failed to get pairing state: %w", err)
+
+				return
+			}
+
+			if state.Status == types.HomePairingStatusFailed {
+				// The box has already ended the session, there is nothing left to stop.
+				channel <- types.HomePairingEvent{
+					State: state,
+					Error: fmt.Errorf("pairing failed with error %q", state.Error),
+				}
+
+				return
+			}
+
+			if state.Status == types.HomePairingStatusIdle {
+				if previous == nil {
+					// The box has not picked the session up yet.
+					continue
+				}
+
+				// The session timed out or was stopped on the box, polling would never see it progress again.
+				channel <- types.HomePairingEvent{
+					State: state,
+					Error: errors.New("pairing session ended before a device was paired"),
+				}
+
+				return
+			}
+
+			if !sameHomePairingStep(previous, &state) {
+				channel <- types.HomePairingEvent{State: state}
+			}
+
+			previous = &state
+
+			if advanced != nil && !sameHomePairingStep(advanced, &state) {
+				acknowledged = true
+			}
+
+			switch state.Status {
+			case types.HomePairingStatusDone:
+				return
+			case types.HomePairingStatusWaiting:
+				if state.Page == nil {
+					continue
+				}
+
+				if sameHomePairingStep(advanced, &state) {
+					if !acknowledged && state.Error == "" {
+						// The box has not processed the submitted input yet.
+						continue
+					}
+
+					// The box rejected the submitted input. The callback is given another chance, while the
+					// default values would only be rejected once more.
+					if options.Advance == nil {
+						finalErr = fmt.Errorf("default values of page %d were rejected", state.Page.ID)
+
+						return
+					}
+				}
+
+				payload, err := homePairingAdvance(options, state)
+				if err != nil {
+					finalErr = fmt.Errorf("failed to build input for page %d: %w", state.Page.ID, err)
+
+					return
+				}
+
+				if err := c.AdvanceHomePairing(ctx, adapterID, payload); err != nil {
+					finalErr = fmt.Errorf("failed to advance pairing: %w", err)
+
+					return
+				}
+
+				advanced = &state
+				acknowledged = false
+			}
+		}
+	}()
+
+	return channel, nil
+}
+
+func sameHomePairingStep(previous, current *types.HomePairingState) bool {
+	if previous == nil {
+		return false
+	}
+
+	if previous.Status != current.Status || previous.Step != current.Step {
+		return false
+	}
+
+	return (previous.Page == nil) == (current.Page == nil) && (previous.Page == nil || previous.Page.ID == current.Page.ID)
+}
+
+// homePairingAdvance builds the input for the current page, falling back to
+// the default values suggested by the box when no Advance callback is set.
+func homePairingAdvance(options types.HomePairingOptions, state types.HomePairingState) (types.HomePairingAdvance, error) {
+	if options.Advance != nil {
+		return options.Advance(state)
+	}
+
+	payload := types.HomePairingAdvance{
+		PageID: state.Page.ID,
+		Fields: make(map[string]interface{}, len(state.Page.Fields)),
+	}
+
+	for _, field := range state.Page.Fields {
+		var value interface{}
+		if len(field.Value) > 0 {
+			if err := json.Unmarshal(field.Value, &value); err != nil {
+				return payload, fmt.Errorf("failed to decode default value of field %s: %w", field.Name, err)
+			}
+		}
+
+		payload.Fields[field.Name] = value
+	}
+
+	return payload, nil
+}
@@ -0,0 +1,523 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("home pairing", func() {
+	const adapterID = int64(5)
+
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("getting the pairing state", func() {
+		returnedState := new(types.HomePairingState)
+		JustBeforeEach(func() {
+			*returnedState, *returnedErr = freeboxClient.GetHomePairingState(ctx, adapterID)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"state": "waiting",
+								"step": 1,
+								"page": {
+									"id": 2,
+									"title": "Nom du capteur",
+									"fields": [
+										{"name": "label", "label": "Nom", "type": "string", "value": "Capteur"}
+									]
+								}
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct state", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedState).To(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(types.HomePairingStatusWaiting),
+					"Step":   Equal(int64(1)),
+					"Page": PointTo(MatchFields(IgnoreExtras, Fields{
+						"ID":     Equal(int64(2)),
+						"Fields": HaveLen(1),
+					})),
+				}))
+			})
+		})
+		Context("when the adapter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeAdapterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeAdapterNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("starting, advancing and stopping pairing", func() {
+		It("should send the matching actions", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"start": true}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {"label": "Garage"}}}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"stop": true}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+				),
+			)
+
+			Expect(freeboxClient.StartHomePairing(ctx, adapterID)).To(Succeed())
+			Expect(freeboxClient.AdvanceHomePairing(ctx, adapterID, types.HomePairingAdvance{
+				PageID: 2,
+				Fields: map[string]interface{}{"label": "Garage"},
+			})).To(Succeed())
+			Expect(freeboxClient.StopHomePairing(ctx, adapterID)).To(Succeed())
+		})
+	})
+
+	Context("pairing a device", func() {
+		var (
+			options         = new(types.HomePairingOptions)
+			returnedChannel = new(chan types.HomePairingEvent)
+			cancelContext   context.CancelFunc
+		)
+		BeforeEach(func() {
+			ctx, cancelContext = context.WithCancel(context.Background())
+			DeferCleanup(func() { cancelContext() })
+
+			*options = types.HomePairingOptions{
+				PollInterval: time.Millisecond,
+			}
+		})
+		JustBeforeEach(func() {
+			*returnedChannel, *returnedErr = freeboxClient.PairHomeDevice(ctx, adapterID, *options)
+		})
+		collect := func() []types.HomePairingEvent {
+			events := make([]types.HomePairingEvent, 0)
+			for event := range *returnedChannel {
+				events = append(events, event)
+			}
+
+			return events
+		}
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"start": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "searching", "step": 0}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "searching", "step": 0}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"state": "waiting",
+								"step": 1,
+								"page": {
+									"id": 2,
+									"fields": [{"name": "label", "type": "string", "value": "Capteur"}]
+								}
+							}
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {"label": "Capteur"}}}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "done", "step": 2, "node_id": 12}}`),
+					),
+				)
+			})
+			It("should report every state change and advance with the default values", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(3))
+				Expect(events[0].State.Status).To(Equal(types.HomePairingStatusSearching))
+				Expect(events[1].State.Status).To(Equal(types.HomePairingStatusWaiting))
+				Expect(events[2].State).To(MatchFields(IgnoreExtras, Fields{
+					"Status": Equal(types.HomePairingStatusDone),
+					"NodeID": Equal(int64(12)),
+				}))
+				for _, event := range events {
+					Expect(event.Error).To(BeNil())
+				}
+			})
+		})
+		Context("when an advance callback is given", func() {
+			BeforeEach(func() {
+				options.Advance = func(state types.HomePairingState) (types.HomePairingAdvance, error) {
+					return types.HomePairingAdvance{
+						PageID: state.Page.ID,
+						Fields: map[string]interface{}{"label": "Garage"},
+					}, nil
+				}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {"label": "Garage"}}}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "done", "step": 2}}`),
+					),
+				)
+			})
+			It("should submit the values returned by the callback", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(collect()).To(HaveLen(2))
+			})
+		})
+		Context("when the box rejects the values returned by the callback", func() {
+			calls := new(int)
+			BeforeEach(func() {
+				*calls = 0
+				options.Advance = func(state types.HomePairingState) (types.HomePairingAdvance, error) {
+					*calls++
+
+					return types.HomePairingAdvance{
+						PageID: state.Page.ID,
+						Fields: map[string]interface{}{"code": *calls},
+					}, nil
+				}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {"code": 1}}}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}, "error": "invalid_code"}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {"code": 2}}}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "done", "step": 2}}`),
+					),
+				)
+			})
+			It("should call the callback again", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(2))
+				Expect(events[1].State.Status).To(Equal(types.HomePairingStatusDone))
+				Expect(*calls).To(Equal(2))
+			})
+		})
+		Context("when the box has not processed the submitted values yet", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {}}}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "done", "step": 2}}`),
+					),
+				)
+			})
+			It("should keep polling without submitting the page again", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(2))
+				Expect(events[1].State.Status).To(Equal(types.HomePairingStatusDone))
+				Expect(server.ReceivedRequests()).To(HaveLen(7))
+			})
+		})
+		Context("when the box rejects the default values", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"next": {"page_id": 2, "fields": {}}}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}, "error": "invalid_value"}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"stop": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should stop the pairing and report the error", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(2))
+				Expect(events[1].Error).To(MatchError(ContainSubstring("default values of page 2 were rejected")))
+				Expect(server.ReceivedRequests()).To(HaveLen(7))
+			})
+		})
+		Context("when the session ends on the box", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "searching"}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "idle"}}`),
+					),
+				)
+			})
+			It("should report the error without polling any longer", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(2))
+				Expect(events[1].State.Status).To(Equal(types.HomePairingStatusIdle))
+				Expect(events[1].Error).To(MatchError(ContainSubstring("ended before a device was paired")))
+				Expect(server.ReceivedRequests()).To(HaveLen(5))
+			})
+		})
+		Context("when the box has not picked the session up yet", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "idle"}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "done", "step": 2}}`),
+					),
+				)
+			})
+			It("should keep polling", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].State.Status).To(Equal(types.HomePairingStatusDone))
+				Expect(events[0].Error).To(BeNil())
+			})
+		})
+		Context("when the advance callback fails", func() {
+			BeforeEach(func() {
+				options.Advance = func(types.HomePairingState) (types.HomePairingAdvance, error) {
+					return types.HomePairingAdvance{}, errors.New("no input")
+				}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "waiting", "step": 1, "page": {"id": 2}}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"stop": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should stop the pairing and report the error", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(2))
+				Expect(events[1].Error).To(MatchError(ContainSubstring("no input")))
+			})
+		})
+		Context("when the pairing fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "failed", "error": "timeout"}}`),
+					),
+				)
+			})
+			It("should report the error without stopping the pairing", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].State.Status).To(Equal(types.HomePairingStatusFailed))
+				Expect(events[0].Error).To(MatchError(ContainSubstring("timeout")))
+			})
+		})
+		Context("when the context is cancelled", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"state": "searching"}}`),
+						func(http.ResponseWriter, *http.Request) { cancelContext() },
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.VerifyJSON(`{"stop": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should stop the pairing and report the cancellation", func() {
+				Expect(*returnedErr).To(BeNil())
+				events := collect()
+				Expect(events).ToNot(BeEmpty())
+				Expect(events[len(events)-1].Error).To(MatchError(context.Canceled))
+				Expect(server.ReceivedRequests()).To(HaveLen(5))
+			})
+		})
+		Context("when starting the pairing fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/pairing/5", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": false, "error_code": "busy"}`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+				Expect(*returnedChannel).To(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,677 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("home", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	// ── Nodes ───────────────────────────────────────────────────────────────────
+
+	Context("listing home nodes", func() {
+		returnedNodes := new([]types.HomeNode)
+		JustBeforeEach(func() {
+			*returnedNodes, *returnedErr = freeboxClient.ListHomeNodes(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/nodes", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 3,
+									"adapter": 5,
+									"category": "dws",
+									"group": {"label": "Entrée"},
+									"label": "Porte d'entrée",
+									"name": "node_3",
+									"status": "active",
+									"type": {
+										"name": "node::domus::sensor::dws",
+										"label": "Détecteur d'ouverture",
+										"physical": true
+									},
+									"props": {"Battery": 100},
+									"show_endpoints": [
+										{
+											"id": 7,
+											"ep_type": "signal",
+											"name": "trigger",
+											"label": "Déclencheur",
+											"value": false,
+											"value_type": "bool",
+											"visibility": "normal"
+										}
+									],
+									"signal_links": [],
+									"slot_links": []
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct nodes", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNodes).To(HaveLen(1))
+				Expect((*returnedNodes)[0]).To(MatchFields(IgnoreExtras, Fields{
+					"ID":        Equal(int64(3)),
+					"AdapterID": Equal(int64(5)),
+					"Category":  Equal("dws"),
+					"Group":     Equal(types.HomeNodeGroup{Label: "Entrée"}),
+					"Status":    Equal(types.HomeNodeStatusActive),
+					"Type": MatchFields(IgnoreExtras, Fields{
+						"Physical": Equal(true),
+					}),
+					"Props": HaveKeyWithValue("Battery", BeNumerically("==", 100)),
+				}))
+				endpoints := (*returnedNodes)[0].ShowEndpoints
+				Expect(endpoints).To(HaveLen(1))
+				Expect(endpoints[0].Type).To(Equal(types.HomeEndpointTypeSignal))
+				Expect(endpoints[0].BoolValue()).To(BeFalse())
+			})
+		})
+		Context("when there are no nodes", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/nodes", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNodes).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+		Context("when the context is nil", func() {
+			BeforeEach(func() {
+				ctx = nil
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a home node", func() {
+		const identifier = int64(3)
+		returnedNode := new(types.HomeNode)
+		JustBeforeEach(func() {
+			*returnedNode, *returnedErr = freeboxClient.GetHomeNode(ctx, identifier)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/nodes/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 3,
+								"label": "Porte d'entrée",
+								"status": "unreachable"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct node", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNode).To(MatchFields(IgnoreExtras, Fields{
+					"ID":     Equal(identifier),
+					"Label":  Equal("Porte d'entrée"),
+					"Status": Equal(types.HomeNodeStatusUnreachable),
+				}))
+			})
+		})
+		Context("when the node is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/nodes/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeNodeNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeNodeNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a home node", func() {
+		const identifier = int64(3)
+		returnedNode := new(types.HomeNode)
+		JustBeforeEach(func() {
+			*returnedNode, *returnedErr = freeboxClient.UpdateHomeNode(ctx, identifier, types.HomeNodePayload{
+				Label: "Porte du garage",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/home/nodes/3", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"label": "Porte du garage"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 3,
+								"label": "Porte du garage"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated node", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedNode.Label).To(Equal("Porte du garage"))
+			})
+		})
+		Context("when the node is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/home/nodes/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeNodeNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeNodeNotFound))
+			})
+		})
+	})
+
+	Context("deleting a home node", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteHomeNode(ctx, 3)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/home/nodes/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the node is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/home/nodes/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeNodeNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeNodeNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── Adapters ────────────────────────────────────────────────────────────────
+
+	Context("listing home adapters", func() {
+		returnedAdapters := new([]types.HomeAdapter)
+		JustBeforeEach(func() {
+			*returnedAdapters, *returnedErr = freeboxClient.ListHomeAdapters(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/adapters", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 5,
+									"label": "Domus",
+									"default_name": "Domus",
+									"status": "active",
+									"uid": "fbx-domus"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct adapters", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedAdapters).To(ConsistOf(types.HomeAdapter{
+					ID:          5,
+					Label:       "Domus",
+					DefaultName: "Domus",
+					Status:      types.HomeAdapterStatusActive,
+					UID:         "fbx-domus",
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a home adapter", func() {
+		returnedAdapter := new(types.HomeAdapter)
+		JustBeforeEach(func() {
+			*returnedAdapter, *returnedErr = freeboxClient.GetHomeAdapter(ctx, 5)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/adapters/5", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 5,
+								"label": "Domus",
+								"status": "active"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct adapter", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedAdapter.Label).To(Equal("Domus"))
+			})
+		})
+		Context("when the adapter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/adapters/5", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeAdapterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeAdapterNotFound))
+			})
+		})
+	})
+
+	// ── Endpoints ───────────────────────────────────────────────────────────────
+
+	Context("getting an endpoint value", func() {
+		returnedValue := new(types.HomeEndpointValue)
+		JustBeforeEach(func() {
+			*returnedValue, *returnedErr = freeboxClient.GetHomeEndpointValue(ctx, 3, 7)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/endpoints/3/7", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"value": 87,
+								"value_type": "int",
+								"refresh": 2000
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct value", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedValue.ValueType).To(Equal(types.HomeEndpointValueTypeInt))
+				Expect(returnedValue.Refresh).To(Equal(int64(2000)))
+				Expect(returnedValue.IntValue()).To(Equal(int64(87)))
+			})
+		})
+		Context("when the endpoint is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/endpoints/3/7", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeEndpointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeEndpointNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("setting an endpoint value", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.SetHomeEndpointValue(ctx, 3, 8, types.HomeEndpointValuePayload{
+				Value: true,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/home/endpoints/3/8", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"value": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the endpoint is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/home/endpoints/3/8", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeEndpointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeEndpointNotFound))
+			})
+		})
+	})
+
+	Context("bulk-getting endpoint values", func() {
+		returnedValues := new([]types.HomeEndpointValue)
+		JustBeforeEach(func() {
+			*returnedValues, *returnedErr = freeboxClient.GetHomeEndpointValues(ctx, []types.HomeEndpointReference{
+				{NodeID: 3, EndpointID: 7},
+				{NodeID: 4, EndpointID: 1},
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/home/endpoints/get", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`[
+							{"node_id": 3, "ep_id": 7},
+							{"node_id": 4, "ep_id": 1}
+						]`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{"node_id": 3, "ep_id": 7, "value": true, "value_type": "bool"},
+								{"node_id": 4, "ep_id": 1, "value": "idle", "value_type": "string"}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct values", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedValues).To(HaveLen(2))
+				Expect((*returnedValues)[0].NodeID).To(Equal(int64(3)))
+				Expect((*returnedValues)[0].BoolValue()).To(BeTrue())
+				Expect((*returnedValues)[1].EndpointID).To(Equal(int64(1)))
+				Expect((*returnedValues)[1].StringValue()).To(Equal(types.HomeAlarmStateIdle))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── Links ───────────────────────────────────────────────────────────────────
+
+	Context("listing home links", func() {
+		returnedLinks := new([]types.HomeLink)
+		JustBeforeEach(func() {
+			*returnedLinks, *returnedErr = freeboxClient.ListHomeLinks(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/links", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"adapter": 5,
+									"label": "Télécommande",
+									"category": "kfb",
+									"status": "active"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct links", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLinks).To(ConsistOf(types.HomeLink{
+					ID:        1,
+					AdapterID: 5,
+					Label:     "Télécommande",
+					Category:  "kfb",
+					Status:    "active",
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a home link", func() {
+		returnedLink := new(types.HomeLink)
+		JustBeforeEach(func() {
+			*returnedLink, *returnedErr = freeboxClient.GetHomeLink(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/links/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 1, "label": "Télécommande"}
+						}`),
+					),
+				)
+			})
+			It("should return the correct link", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedLink.Label).To(Equal("Télécommande"))
+			})
+		})
+		Context("when the link is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/links/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrHomeLinkNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrHomeLinkNotFound))
+			})
+		})
+	})
+
+	// ── Security module ─────────────────────────────────────────────────────────
+
+	Context("getting the security module", func() {
+		returnedModule := new(types.HomeSecurityModule)
+		JustBeforeEach(func() {
+			*returnedModule, *returnedErr = freeboxClient.GetHomeSecurityModule(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/home/secmod", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"node_id": 4,
+								"state": "alarm1_armed",
+								"label": "Alarme"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct security module", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedModule).To(Equal(types.HomeSecurityModule{
+					NodeID: 4,
+					State:  types.HomeAlarmStateArmedMain,
+					Label:  "Alarme",
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
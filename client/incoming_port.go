@@ -0,0 +1,93 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeIncomingPortNotFound = "noent"
+)
+
+func (c *client) ListIncomingPorts(ctx context.Context) (result []types.IncomingPort, err error) {
+	response, err := c.get(ctx, "fw/incoming/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET fw/incoming/ endpoint: %w", err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to list incoming ports from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) GetIncomingPort(ctx context.Context, identifier types.IncomingPortID) (result types.IncomingPort, err error) {
+	response, err := c.get(ctx, "fw/incoming/"+identifier, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeIncomingPortNotFound {
+			return result, ErrIncomingPortNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET fw/incoming/%s endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get incoming port from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// UpdateIncomingPort updates the incoming port with the given identifier. The port number, if set, is checked
+// against the range of ports usable on the public IPv4 address before sending the request, unless the connection
+// status can not be read.
+func (c *client) UpdateIncomingPort(
+	ctx context.Context,
+	identifier types.IncomingPortID,
+	payload types.IncomingPortPayload,
+) (result types.IncomingPort, err error) {
+	if payload.InPort != 0 {
+		if err = c.checkPortsInRange(ctx, payload.InPort); errors.Is(err, ErrPortOutsideRange) {
+			return result, err
+		}
+	}
+
+	response, err := c.put(ctx, "fw/incoming/"+identifier, payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeIncomingPortNotFound {
+			return result, ErrIncomingPortNotFound
+		}
+
+		return result, fmt.Errorf("failed to PUT fw/incoming/%s endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update incoming port from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// checkPortsInRange returns ErrPortOutsideRange if any of the given ports is not usable on the public IPv4 address.
+func (c *client) checkPortsInRange(ctx context.Context, ports ...int64) error {
+	status, err := c.GetConnectionStatus(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get the connection status to check the ipv4 port range: %w", err)
+	}
+
+	for _, port := range ports {
+		if !status.PortInRange(port) {
+			return fmt.Errorf("%w: port %d is not within %d-%d", ErrPortOutsideRange, port, status.IPv4PortRange[0], status.IPv4PortRange[1])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("incoming ports", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing incoming ports", func() {
+		returnedPorts := new([]types.IncomingPort)
+		JustBeforeEach(func() {
+			*returnedPorts, *returnedErr = freeboxClient.ListIncomingPorts(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/fw/incoming/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"enabled": true,
+									"active": true,
+									"id": "http",
+									"in_port": 16443,
+									"type": "tcp",
+									"readonly": false,
+									"netns": "init"
+								},
+								{
+									"enabled": false,
+									"active": false,
+									"id": "bittorrent-dht",
+									"in_port": 16881,
+									"type": "udp",
+									"readonly": false,
+									"netns": "init"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct incoming ports", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPorts).To(HaveLen(2))
+				Expect((*returnedPorts)[0]).To(MatchFields(IgnoreExtras, Fields{
+					"ID":         Equal(types.IncomingPortHTTP),
+					"Active":     BeTrue(),
+					"IPProtocol": Equal(types.TCP),
+					"IncomingPortPayload": Equal(types.IncomingPortPayload{
+						Enabled: func(b bool) *bool { return &b }(true),
+						InPort:  16443,
+					}),
+				}))
+				Expect((*returnedPorts)[1].ID).To(Equal(types.IncomingPortBittorrentDHT))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/fw/incoming/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPorts).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting an incoming port", func() {
+		returnedPort := new(types.IncomingPort)
+		JustBeforeEach(func() {
+			*returnedPort, *returnedErr = freeboxClient.GetIncomingPort(ctx, types.IncomingPortFTP)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/fw/incoming/ftp", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": false, "active": false, "id": "ftp", "in_port": 16021, "type": "tcp", "readonly": true}
+						}`),
+					),
+				)
+			})
+			It("should return the correct incoming port", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedPort.InPort).To(Equal(int64(16021)))
+				Expect(returnedPort.Readonly).To(BeTrue())
+			})
+		})
+		Context("when the incoming port is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/fw/incoming/ftp", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrIncomingPortNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrIncomingPortNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating an incoming port", func() {
+		var (
+			payload      = new(types.IncomingPortPayload)
+			returnedPort = new(types.IncomingPort)
+		)
+		BeforeEach(func() {
+			*payload = types.IncomingPortPayload{InPort: 20000}
+		})
+		JustBeforeEach(func() {
+			*returnedPort, *returnedErr = freeboxClient.UpdateIncomingPort(ctx, types.IncomingPortHTTP, *payload)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"ipv4_port_range": [16384, 32767]}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/fw/incoming/http", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"in_port": 20000}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"enabled": true, "active": true, "id": "http", "in_port": 20000, "type": "tcp"}
+						}`),
+					),
+				)
+			})
+			It("should return the updated incoming port", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedPort.InPort).To(Equal(int64(20000)))
+			})
+		})
+		Context("when the port is outside of the ipv4 port range", func() {
+			BeforeEach(func() {
+				payload.InPort = 8080
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"ipv4_port_range": [16384, 32767]}}`),
+					),
+				)
+			})
+			It("should return ErrPortOutsideRange without sending the update", func() {
+				Expect(*returnedErr).To(MatchError(client.ErrPortOutsideRange))
+				Expect(*returnedErr).To(MatchError(ContainSubstring("port 8080 is not within 16384-32767")))
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+		Context("when the connection status can not be read", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "insufficient_rights"}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/fw/incoming/http", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"in_port": 20000}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": "http", "in_port": 20000}}`),
+					),
+				)
+			})
+			It("should update the incoming port without checking the port", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedPort.InPort).To(Equal(int64(20000)))
+			})
+		})
+		Context("when only enabling the port", func() {
+			BeforeEach(func() {
+				enabled := true
+				*payload = types.IncomingPortPayload{Enabled: &enabled}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/fw/incoming/http", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"enabled": true}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true, "id": "http"}}`),
+					),
+				)
+			})
+			It("should not check the ipv4 port range", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPort.Enabled).To(BeTrue())
+			})
+		})
+		Context("when the incoming port is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/connection/", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/fw/incoming/http", version)),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrIncomingPortNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrIncomingPortNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -79,3 +79,46 @@ func (c *client) GetLanInterfaceHost(ctx context.Context, interfaceName, identif
 
 	return result, nil
 }
+
+func (c *client) UpdateLanInterfaceHost(
+	ctx context.Context,
+	interfaceName, identifier string,
+	payload types.LanInterfaceHostPayload,
+) (result types.LanInterfaceHost, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("lan/browser/%s/%s", interfaceName, identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == interfaceNotFoundCode {
+			return result, ErrInterfaceNotFound
+		}
+
+		if response != nil && response.ErrorCode == interfaceHostNotFoundCode {
+			return result, ErrInterfaceHostNotFound
+		}
+
+		return result, fmt.Errorf("failed to PUT lan/browser/%s/%s endpoint: %w", interfaceName, identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get updated lan interface host from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) WakeOnLAN(ctx context.Context, interfaceName, mac, password string) error {
+	payload := types.WakeOnLANPayload{
+		Mac:      mac,
+		Password: password,
+	}
+
+	response, err := c.post(ctx, fmt.Sprintf("lan/wol/%s/", interfaceName), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == interfaceNotFoundCode {
+			return ErrInterfaceNotFound
+		}
+
+		return fmt.Errorf("failed to POST lan/wol/%s/ endpoint: %w", interfaceName, err)
+	}
+
+	return nil
+}
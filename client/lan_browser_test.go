@@ -657,4 +657,165 @@ var _ = Describe("lan browser", func() {
 			})
 		})
 	})
+	Context("updating a lan interface host", func() {
+		const (
+			interfaceName  = "pub"
+			hostIdentifier = "ether-7e:ec:37:cd:5b:6a"
+		)
+		returnedHost := new(types.LanInterfaceHost)
+		JustBeforeEach(func() {
+			persistent := true
+			*returnedHost, *returnedErr = freeboxClient.UpdateLanInterfaceHost(context.Background(), interfaceName, hostIdentifier, types.LanInterfaceHostPayload{
+				PrimaryName: "Living room TV",
+				Type:        types.Television,
+				Persistent:  &persistent,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/lan/browser/%s/%s", version, interfaceName, hostIdentifier)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"primary_name": "Living room TV",
+							"host_type": "television",
+							"persistent": true
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": "ether-7e:ec:37:cd:5b:6a",
+								"primary_name": "Living room TV",
+								"primary_name_manual": true,
+								"host_type": "television",
+								"persistent": true
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated host", func() {
+				Expect(*returnedErr).ToNot(HaveOccurred())
+				Expect(returnedHost.PrimaryName).To(Equal("Living room TV"))
+				Expect(returnedHost.PrimaryNameManual).To(BeTrue())
+				Expect(returnedHost.Type).To(Equal(types.Television))
+				Expect(returnedHost.Persistent).To(BeTrue())
+			})
+		})
+		Context("when the interface does not exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/lan/browser/%s/%s", version, interfaceName, hostIdentifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "nodev",
+							"msg": "Interface invalide"
+						}`),
+					),
+				)
+			})
+			It("should return the correct error", func() {
+				Expect(*returnedErr).To(Equal(client.ErrInterfaceNotFound))
+			})
+		})
+		Context("when the host does not exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/lan/browser/%s/%s", version, interfaceName, hostIdentifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "nohost",
+							"msg": "Pas d'hôte avec cet identifiant"
+						}`),
+					),
+				)
+			})
+			It("should return the correct error", func() {
+				Expect(*returnedErr).To(Equal(client.ErrInterfaceHostNotFound))
+			})
+		})
+		Context("when server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+	Context("waking up a host on lan", func() {
+		const (
+			interfaceName = "pub"
+			mac           = "7e:ec:37:cd:5b:6a"
+		)
+		password := new(string)
+		BeforeEach(func() {
+			*password = ""
+		})
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.WakeOnLAN(context.Background(), interfaceName, mac, *password)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/lan/wol/%s/", version, interfaceName)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"mac": "7e:ec:37:cd:5b:6a", "password": ""}`),
+						ghttp.RespondWith(http.StatusOK, `{"success":true}`),
+					),
+				)
+			})
+			It("should return no error", func() {
+				Expect(*returnedErr).ToNot(HaveOccurred())
+			})
+		})
+		Context("when a password is given", func() {
+			BeforeEach(func() {
+				*password = "00:11:22:33:44:55"
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/lan/wol/%s/", version, interfaceName)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"mac": "7e:ec:37:cd:5b:6a", "password": "00:11:22:33:44:55"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success":true}`),
+					),
+				)
+			})
+			It("should return no error", func() {
+				Expect(*returnedErr).ToNot(HaveOccurred())
+			})
+		})
+		Context("when the interface does not exist", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/lan/wol/%s/", version, interfaceName)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "nodev",
+							"msg": "Interface invalide"
+						}`),
+					),
+				)
+			})
+			It("should return the correct error", func() {
+				Expect(*returnedErr).To(Equal(client.ErrInterfaceNotFound))
+			})
+		})
+		Context("when server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
 })
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+func (c *client) GetLCDConfiguration(ctx context.Context) (result types.LCDConfiguration, err error) {
+	response, err := c.get(ctx, "lcd/config/", c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to GET lcd/config/ endpoint: %w", err)
+	}
+
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return types.LCDConfiguration{}, fmt.Errorf("failed to get LCD configuration from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateLCDConfiguration(ctx context.Context, payload types.LCDConfiguration) (result types.LCDConfiguration, err error) {
+	response, err := c.put(ctx, "lcd/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to PUT lcd/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update LCD configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
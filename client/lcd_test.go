@@ -0,0 +1,126 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("lcd", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("getting the LCD configuration", func() {
+		returnedConfig := new(types.LCDConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetLCDConfiguration(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/lcd/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"brightness": 75, "orientation": 90, "orientation_forced": true, "hide_wifi_key": true}
+						}`),
+					),
+				)
+			})
+			It("should return the correct LCD configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.LCDConfiguration{
+					Brightness:        75,
+					Orientation:       types.LCDOrientation90,
+					OrientationForced: true,
+					HideWifiKey:       true,
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the LCD configuration", func() {
+		returnedConfig := new(types.LCDConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateLCDConfiguration(ctx, types.LCDConfiguration{
+				Brightness:        75,
+				Orientation:       types.LCDOrientation90,
+				OrientationForced: true,
+				HideWifiKey:       true,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/lcd/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"brightness": 75, "orientation": 90, "orientation_forced": true, "hide_wifi_key": true}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"brightness": 75, "orientation": 90, "orientation_forced": true, "hide_wifi_key": true}
+						}`),
+					),
+				)
+			})
+			It("should return the updated LCD configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.LCDConfiguration{
+					Brightness:        75,
+					Orientation:       types.LCDOrientation90,
+					OrientationForced: true,
+					HideWifiKey:       true,
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"strings"
+	"unicode"
+)
+
+// Call is a call to the Freebox API, as seen by the middlewares.
+type Call struct {
+	Operation string         // name of the client method performing the call, such as CreatePortForwardingRule
+	Request   *http.Request  // request sent to the box, replace it to pass a new context down the chain
+	Response  *http.Response // response to the last attempt, nil when it failed before getting one. Its body is already consumed
+	WebSocket bool           // whether the request is the handshake of a websocket
+}
+
+// Invoker performs a call to the Freebox API.
+type Invoker func(call *Call) error
+
+// Middleware wraps the calls performed by the client, for instance to observe their outcome.
+type Middleware interface {
+	Wrap(next Invoker) Invoker
+}
+
+// MiddlewareFunc allows using a plain function as a Middleware.
+type MiddlewareFunc func(next Invoker) Invoker
+
+func (f MiddlewareFunc) Wrap(next Invoker) Invoker {
+	return f(next)
+}
+
+// invoke performs the call through the middlewares, the first one registered being the outermost.
+func (c *client) invoke(call *Call, invoker Invoker) error {
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		invoker = c.middlewares[i].Wrap(invoker)
+	}
+
+	return invoker(call)
+}
+
+type operationKey struct{}
+
+// withOperation names the calls made with the returned context, for those not made directly by a client method.
+func withOperation(ctx context.Context, operation string) context.Context {
+	return context.WithValue(ctx, operationKey{}, operation)
+}
+
+var clientMethodPrefix = reflect.TypeOf(client{}).PkgPath() + ".(*client)."
+
+// operationName returns the name of the exported client method performing the request.
+func operationName(request *http.Request) string {
+	if operation, ok := request.Context().Value(operationKey{}).(string); ok {
+		return operation
+	}
+
+	callers := make([]uintptr, 64)
+	frames := runtime.CallersFrames(callers[:runtime.Callers(2, callers)])
+
+	for {
+		frame, more := frames.Next()
+
+		if method, found := strings.CutPrefix(frame.Function, clientMethodPrefix); found {
+			method, _, _ = strings.Cut(method, ".") // closures are named after their method, such as Method.func1
+			if method != "" && unicode.IsUpper(rune(method[0])) {
+				return method
+			}
+		}
+
+		if !more {
+			return fmt.Sprintf("%s %s", request.Method, request.URL.Path)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const redacted = "REDACTED"
+
+// NewLoggingMiddleware returns a Middleware logging every call with the given logger: failed calls at the warning
+// level, the others at the debug level. Request headers and bodies are only logged at the debug level, with the
+// session token and the passwords they hold redacted.
+func NewLoggingMiddleware(logger *slog.Logger) Middleware {
+	return MiddlewareFunc(func(next Invoker) Invoker {
+		return func(call *Call) error {
+			ctx := call.Request.Context()
+
+			attributes := []slog.Attr{
+				slog.String("operation", call.Operation),
+				slog.String("method", call.Request.Method),
+				slog.String("url", call.Request.URL.Redacted()),
+			}
+
+			if logger.Enabled(ctx, slog.LevelDebug) {
+				attributes = append(attributes, slog.Any("headers", redactHeaders(call.Request.Header)))
+				if body := redactBody(call.Request); body != "" {
+					attributes = append(attributes, slog.String("body", body))
+				}
+			}
+
+			start := time.Now()
+			err := next(call)
+
+			attributes = append(attributes, slog.Duration("duration", time.Since(start)))
+			if call.Response != nil {
+				attributes = append(attributes, slog.Int("status", call.Response.StatusCode))
+			}
+
+			if err != nil {
+				apiError := new(APIError)
+				if errors.As(err, &apiError) {
+					attributes = append(attributes, slog.String("error_code", apiError.Code))
+				}
+
+				attributes = append(attributes, slog.String("error", err.Error()))
+				logger.LogAttrs(ctx, slog.LevelWarn, "freebox call failed", attributes...)
+
+				return err
+			}
+
+			logger.LogAttrs(ctx, slog.LevelDebug, "freebox call succeeded", attributes...)
+
+			return nil
+		}
+	})
+}
+
+func redactHeaders(headers http.Header) http.Header {
+	headers = headers.Clone()
+	if headers.Get(AuthHeader) != "" {
+		headers.Set(AuthHeader, redacted)
+	}
+
+	return headers
+}
+
+// redactBody returns the body of the request with its passwords redacted, without consuming it.
+func redactBody(request *http.Request) string {
+	if request.GetBody == nil {
+		return ""
+	}
+
+	reader, err := request.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer reader.Close()
+
+	body, err := io.ReadAll(reader)
+	if err != nil || len(body) == 0 {
+		return ""
+	}
+
+	if strings.HasPrefix(request.Header.Get("Content-Type"), "application/x-www-form-urlencoded") {
+		values, err := url.ParseQuery(string(body))
+		if err != nil {
+			return redacted
+		}
+
+		for key := range values {
+			if isSecret(key) {
+				values.Set(key, redacted)
+			}
+		}
+
+		return values.Encode()
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return redacted // unknown formats may hold secrets as well
+	}
+
+	encoded, err := json.Marshal(redactJSON(decoded))
+	if err != nil {
+		return redacted
+	}
+
+	return string(encoded)
+}
+
+func redactJSON(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, field := range typed {
+			if isSecret(key) {
+				typed[key] = redacted
+			} else {
+				typed[key] = redactJSON(field)
+			}
+		}
+	case []interface{}:
+		for i, item := range typed {
+			typed[i] = redactJSON(item)
+		}
+	}
+
+	return value
+}
+
+// isSecret reports whether a field holds a password or a key, such as the password of a VPN user or a Wi-Fi key.
+func isSecret(field string) bool {
+	field = strings.ToLower(field)
+
+	return field == "password" || strings.HasSuffix(field, "_password") || field == "key" || strings.HasSuffix(field, "_token")
+}
@@ -0,0 +1,252 @@
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("middlewares", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("when middlewares are registered", func() {
+		type observedCall struct {
+			middleware string
+			operation  string
+			status     int
+			webSocket  bool
+			err        error
+		}
+		observed := new([]observedCall)
+		recorder := func(name string) client.Middleware {
+			return client.MiddlewareFunc(func(next client.Invoker) client.Invoker {
+				return func(call *client.Call) error {
+					err := next(call)
+					status := 0
+					if call.Response != nil {
+						status = call.Response.StatusCode
+					}
+					*observed = append(*observed, observedCall{
+						middleware: name,
+						operation:  call.Operation,
+						status:     status,
+						webSocket:  call.WebSocket,
+						err:        err,
+					})
+
+					return err
+				}
+			})
+		}
+		BeforeEach(func() {
+			*observed = nil
+			freeboxClient.WithMiddlewares(recorder("outer"), recorder("inner"))
+		})
+		Context("performing a regular call", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			JustBeforeEach(func() {
+				_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+			})
+			It("should go through the middlewares named after the operation", func() {
+				Expect(*returnedErr).To(MatchError(&client.APIError{Code: "noent"}))
+				Expect(*observed).To(HaveLen(6))
+				Expect((*observed)[0]).To(Equal(observedCall{middleware: "inner", operation: "Login", status: http.StatusOK}))
+				Expect((*observed)[1]).To(Equal(observedCall{middleware: "outer", operation: "Login", status: http.StatusOK}))
+				Expect((*observed)[4].middleware).To(Equal("inner"))
+				Expect((*observed)[5].middleware).To(Equal("outer"))
+				Expect((*observed)[5].operation).To(Equal("GetUPnPAVConfiguration"))
+				Expect((*observed)[5].status).To(Equal(http.StatusNotFound))
+				Expect((*observed)[5].err).To(MatchError(&client.APIError{Code: "noent"}))
+			})
+		})
+		Context("performing a raw call", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/vpn/download_config/openvpn_routed/perreux", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, "client\n"),
+					),
+				)
+			})
+			JustBeforeEach(func() {
+				_, *returnedErr = freeboxClient.GetVPNUserClientConfig(ctx, "perreux")
+			})
+			It("should go through the middlewares", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*observed).To(HaveLen(6))
+				Expect((*observed)[5]).To(Equal(observedCall{middleware: "outer", operation: "GetVPNUserClientConfig", status: http.StatusOK}))
+			})
+		})
+		Context("downloading a file", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/dl/%s", version, base64.StdEncoding.EncodeToString([]byte("/Freebox/file.txt")))),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "insufficient_rights"}`),
+					),
+				)
+			})
+			JustBeforeEach(func() {
+				_, *returnedErr = freeboxClient.GetFile(ctx, "/Freebox/file.txt")
+			})
+			It("should go through the middlewares", func() {
+				Expect(*returnedErr).To(MatchError(&client.APIError{Code: "insufficient_rights"}))
+				Expect(*observed).To(HaveLen(6))
+				Expect((*observed)[5].operation).To(Equal("GetFile"))
+				Expect((*observed)[5].status).To(Equal(http.StatusForbidden))
+			})
+		})
+		Context("probing the API version", func() {
+			BeforeEach(func() {
+				server.SetHandler(0, ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/api_version", version)),
+					ghttp.RespondWith(http.StatusOK, `{"api_version": "10.0"}`),
+				))
+			})
+			JustBeforeEach(func() {
+				_, *returnedErr = freeboxClient.APIVersion(ctx)
+			})
+			It("should go through the middlewares", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*observed).To(Equal([]observedCall{
+					{middleware: "inner", operation: "APIVersion", status: http.StatusOK},
+					{middleware: "outer", operation: "APIVersion", status: http.StatusOK},
+				}))
+			})
+		})
+		Context("dialing a websocket", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusInternalServerError)
+				})
+			})
+			JustBeforeEach(func() {
+				_, *returnedErr = freeboxClient.ListenEvents(ctx, []types.EventDescription{{Source: "foo", Name: "bar"}})
+			})
+			It("should go through the middlewares", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+				Expect(*observed).To(HaveLen(6))
+				Expect((*observed)[5].operation).To(Equal("ListenEvents"))
+				Expect((*observed)[5].webSocket).To(BeTrue())
+				Expect((*observed)[5].status).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Context("logging", func() {
+		output := new(bytes.Buffer)
+		BeforeEach(func() {
+			output.Reset()
+			freeboxClient.WithMiddlewares(client.NewLoggingMiddleware(slog.New(slog.NewJSONHandler(output, &slog.HandlerOptions{Level: slog.LevelDebug}))))
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/vpn/user/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"login": "perreux", "password": "correct horse battery staple"}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"login": "perreux", "password_set": true}}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			_, *returnedErr = freeboxClient.CreateVPNUser(ctx, types.VPNUserPayload{
+				Login:    "perreux",
+				Password: "correct horse battery staple",
+			})
+		})
+		It("should log the calls without their secrets", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(output.String()).To(ContainSubstring(`"operation":"Login"`))
+			Expect(output.String()).To(ContainSubstring(`"operation":"CreateVPNUser"`))
+			Expect(output.String()).To(ContainSubstring(`"X-Fbx-App-Auth":["REDACTED"]`))
+			Expect(output.String()).To(ContainSubstring(`\"login\":\"perreux\"`))
+			Expect(output.String()).To(ContainSubstring(`\"password\":\"REDACTED\"`))
+			Expect(output.String()).ToNot(ContainSubstring(*sessionToken))
+			Expect(output.String()).ToNot(ContainSubstring("correct horse battery staple"))
+		})
+	})
+
+	Context("tracing", func() {
+		spans := new(tracetest.SpanRecorder)
+		BeforeEach(func() {
+			*spans = *tracetest.NewSpanRecorder()
+			freeboxClient.WithMiddlewares(client.NewTracingMiddleware(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(spans))))
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/fw/redir/42", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent", "msg": "Invalid id"}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeletePortForwardingRule(ctx, 42)
+		})
+		It("should emit spans named after the operations", func() {
+			Expect(*returnedErr).ToNot(BeNil())
+
+			ended := spans.Ended()
+			Expect(ended).To(HaveLen(3))
+			Expect(ended[0].Name()).To(Equal("Login"))
+			Expect(ended[1].Name()).To(Equal("Login"))
+			Expect(ended[2].Name()).To(Equal("DeletePortForwardingRule"))
+			Expect(ended[2].Status().Code).To(Equal(codes.Error))
+			Expect(ended[2].Attributes()).To(ContainElements(
+				attribute.String("http.request.method", http.MethodDelete),
+				attribute.Int("http.response.status_code", http.StatusNotFound),
+				attribute.String("freebox.error_code", "noent"),
+			))
+		})
+	})
+})
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/nikolalohinski/free-go/client"
+
+// NewTracingMiddleware returns a Middleware emitting an OpenTelemetry span for every call, named after its operation.
+// When the provider is nil, the global one is used.
+func NewTracingMiddleware(provider trace.TracerProvider) Middleware {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+
+	tracer := provider.Tracer(tracerName)
+
+	return MiddlewareFunc(func(next Invoker) Invoker {
+		return func(call *Call) error {
+			ctx, span := tracer.Start(call.Request.Context(), call.Operation,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					attribute.String("http.request.method", call.Request.Method),
+					attribute.String("url.full", call.Request.URL.Redacted()),
+					attribute.Bool("freebox.websocket", call.WebSocket),
+				),
+			)
+			defer span.End()
+
+			call.Request = call.Request.WithContext(ctx)
+
+			err := next(call)
+			if call.Response != nil {
+				span.SetAttributes(attribute.Int("http.response.status_code", call.Response.StatusCode))
+			}
+
+			if err != nil {
+				apiError := new(APIError)
+				if errors.As(err, &apiError) {
+					span.SetAttributes(attribute.String("freebox.error_code", apiError.Code))
+				}
+
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			}
+
+			return err
+		}
+	})
+}
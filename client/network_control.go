@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/nikolalohinski/free-go/types"
 )
@@ -62,3 +63,133 @@ func (c *client) UpdateNetworkControl(ctx context.Context, payload types.Network
 
 	return result, nil
 }
+
+func (c *client) CreateNetworkControl(ctx context.Context, payload types.NetworkControlPayload) (result types.NetworkControlInfo, err error) {
+	response, err := c.post(ctx, "network_control/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to POST network_control/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to create network control from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// PauseProfile denies network access to the hosts of the given profile until the given time. A zero time pauses the
+// profile until the override is lifted.
+func (c *client) PauseProfile(ctx context.Context, profileID types.ProfileID, until time.Time) (result types.NetworkControlInfo, err error) {
+	current, err := c.GetNetworkControl(ctx, profileID)
+	if err != nil {
+		return result, fmt.Errorf("failed to get network control of profile %d: %w", profileID, err)
+	}
+
+	overrideUntil := 0
+	if !until.IsZero() {
+		overrideUntil = int(until.Unix())
+	}
+
+	return c.UpdateNetworkControl(ctx, types.NetworkControlPayload{
+		ProfileID:       profileID,
+		Override:        true,
+		OverrideMode:    types.RuleModeDenied,
+		OverrideUntil:   overrideUntil,
+		Macs:            current.Macs,
+		CustomDayRanges: current.CustomDayRanges,
+	})
+}
+
+func (c *client) ListNetworkControlRules(ctx context.Context, profileID types.ProfileID) (result []types.NetworkControlRule, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("network_control/%d/rules/", profileID), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeNetworkControlNotFound {
+			return nil, ErrNetworkControlNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET network_control/%d/rules/ endpoint: %w", profileID, err)
+	}
+
+	if response.Result == nil {
+		return
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to list network control rules from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) GetNetworkControlRule(ctx context.Context, profileID types.ProfileID, identifier int64) (result types.NetworkControlRule, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("network_control/%d/rules/%d", profileID, identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeNetworkControlNotFound {
+			return result, ErrNetworkControlRuleNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET network_control/%d/rules/%d endpoint: %w", profileID, identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get network control rule from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) CreateNetworkControlRule(
+	ctx context.Context,
+	profileID types.ProfileID,
+	payload types.NetworkControlRulePayload,
+) (result types.NetworkControlRule, err error) {
+	response, err := c.post(ctx, fmt.Sprintf("network_control/%d/rules/", profileID), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeNetworkControlNotFound {
+			return result, ErrNetworkControlNotFound
+		}
+
+		return result, fmt.Errorf("failed to POST network_control/%d/rules/ endpoint: %w", profileID, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to create network control rule from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateNetworkControlRule(
+	ctx context.Context,
+	profileID types.ProfileID,
+	identifier int64,
+	payload types.NetworkControlRulePayload,
+) (result types.NetworkControlRule, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("network_control/%d/rules/%d", profileID, identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeNetworkControlNotFound {
+			return result, ErrNetworkControlRuleNotFound
+		}
+
+		return result, fmt.Errorf("failed to PUT network_control/%d/rules/%d endpoint: %w", profileID, identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update network control rule from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) DeleteNetworkControlRule(ctx context.Context, profileID types.ProfileID, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("network_control/%d/rules/%d", profileID, identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeNetworkControlNotFound {
+			return ErrNetworkControlRuleNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE network_control/%d/rules/%d endpoint: %w", profileID, identifier, err)
+	}
+
+	return nil
+}
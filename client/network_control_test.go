@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -283,4 +284,390 @@ var _ = Describe("network control", func() {
 			})
 		})
 	})
+	// ── CreateNetworkControl ────────────────────────────────────────────────────
+
+	Context("creating a network control", func() {
+		returnedControl := new(types.NetworkControlInfo)
+		JustBeforeEach(func() {
+			*returnedControl, *returnedErr = freeboxClient.CreateNetworkControl(ctx, types.NetworkControlPayload{
+				ProfileID:    3,
+				OverrideMode: types.RuleModeAllowed,
+				Macs:         []string{"00:11:22:33:44:55"},
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/network_control/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"profile_id": 3,
+							"override_mode": "allowed",
+							"override_until": 0,
+							"override": false,
+							"macs": ["00:11:22:33:44:55"],
+							"cdayranges": null
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"profile_id": 3, "current_mode": "allowed", "macs": ["00:11:22:33:44:55"]}
+						}`),
+					),
+				)
+			})
+			It("should return the created network control", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedControl.ProfileID).To(Equal(int64(3)))
+				Expect(returnedControl.CurrentMode).To(Equal(types.RuleModeAllowed))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── PauseProfile ────────────────────────────────────────────────────────────
+
+	Context("pausing a profile", func() {
+		var (
+			until           = new(time.Time)
+			returnedControl = new(types.NetworkControlInfo)
+		)
+		BeforeEach(func() {
+			*until = time.Unix(1700000000, 0)
+		})
+		JustBeforeEach(func() {
+			*returnedControl, *returnedErr = freeboxClient.PauseProfile(ctx, 3, *until)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"profile_id": 3,
+								"override": false,
+								"override_mode": "allowed",
+								"current_mode": "allowed",
+								"macs": ["00:11:22:33:44:55"],
+								"cdayranges": [":fr_bank_holidays"]
+							}
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/network_control/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"profile_id": 3,
+							"override": true,
+							"override_mode": "denied",
+							"override_until": 1700000000,
+							"macs": ["00:11:22:33:44:55"],
+							"cdayranges": [":fr_bank_holidays"]
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"profile_id": 3,
+								"override": true,
+								"override_mode": "denied",
+								"override_until": 1700000000,
+								"current_mode": "denied"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the overridden network control", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedControl).To(MatchFields(IgnoreExtras, Fields{
+					"Override":      BeTrue(),
+					"OverrideMode":  Equal(types.RuleModeDenied),
+					"OverrideUntil": Equal(1700000000),
+					"CurrentMode":   Equal(types.RuleModeDenied),
+				}))
+			})
+		})
+		Context("when pausing without end", func() {
+			BeforeEach(func() {
+				*until = time.Time{}
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3", version)),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"profile_id": 3}}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/network_control/3", version)),
+						ghttp.VerifyJSON(`{
+							"profile_id": 3,
+							"override": true,
+							"override_mode": "denied",
+							"override_until": 0,
+							"macs": null,
+							"cdayranges": null
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"profile_id": 3, "override": true}}`),
+					),
+				)
+			})
+			It("should set an unlimited override", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedControl.Override).To(BeTrue())
+			})
+		})
+		Context("when the network control is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3", version)),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrNetworkControlNotFound", func() {
+				Expect(*returnedErr).To(MatchError(client.ErrNetworkControlNotFound))
+			})
+		})
+	})
+
+	// ── Rules ───────────────────────────────────────────────────────────────────
+
+	Context("listing network control rules", func() {
+		returnedRules := new([]types.NetworkControlRule)
+		JustBeforeEach(func() {
+			*returnedRules, *returnedErr = freeboxClient.ListNetworkControlRules(ctx, 3)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3/rules/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"profile_id": 3,
+									"enabled": true,
+									"name": "bedtime",
+									"mode": "denied",
+									"start_time": 79200,
+									"end_time": 25200,
+									"weekdays": [true, true, true, true, true, false, false]
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct rules", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedRules).To(Equal([]types.NetworkControlRule{{
+					ID:        1,
+					ProfileID: 3,
+					NetworkControlRulePayload: types.NetworkControlRulePayload{
+						Enabled:   true,
+						Name:      "bedtime",
+						Mode:      types.RuleModeDenied,
+						StartTime: 79200,
+						EndTime:   25200,
+						Weekdays:  []bool{true, true, true, true, true, false, false},
+					},
+				}}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3/rules/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedRules).To(BeEmpty())
+			})
+		})
+		Context("when the network control is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3/rules/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrNetworkControlNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrNetworkControlNotFound))
+			})
+		})
+	})
+
+	Context("getting a network control rule", func() {
+		returnedRule := new(types.NetworkControlRule)
+		JustBeforeEach(func() {
+			*returnedRule, *returnedErr = freeboxClient.GetNetworkControlRule(ctx, 3, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3/rules/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 1, "profile_id": 3, "name": "bedtime", "mode": "webonly"}}`),
+					),
+				)
+			})
+			It("should return the correct rule", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedRule.Mode).To(Equal(types.RuleModeWebOnly))
+			})
+		})
+		Context("when the rule is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/network_control/3/rules/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrNetworkControlRuleNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrNetworkControlRuleNotFound))
+			})
+		})
+	})
+
+	Context("creating a network control rule", func() {
+		returnedRule := new(types.NetworkControlRule)
+		JustBeforeEach(func() {
+			*returnedRule, *returnedErr = freeboxClient.CreateNetworkControlRule(ctx, 3, types.NetworkControlRulePayload{
+				Enabled:   true,
+				Name:      "bedtime",
+				Mode:      types.RuleModeDenied,
+				StartTime: 79200,
+				EndTime:   25200,
+				Weekdays:  []bool{true, true, true, true, true, false, false},
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/network_control/3/rules/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"enabled": true,
+							"name": "bedtime",
+							"mode": "denied",
+							"start_time": 79200,
+							"end_time": 25200,
+							"weekdays": [true, true, true, true, true, false, false]
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 4, "profile_id": 3, "name": "bedtime"}}`),
+					),
+				)
+			})
+			It("should return the created rule", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedRule.ID).To(Equal(int64(4)))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a network control rule", func() {
+		returnedRule := new(types.NetworkControlRule)
+		JustBeforeEach(func() {
+			*returnedRule, *returnedErr = freeboxClient.UpdateNetworkControlRule(ctx, 3, 4, types.NetworkControlRulePayload{
+				Name: "bedtime",
+				Mode: types.RuleModeDenied,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/network_control/3/rules/4", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 4, "profile_id": 3, "enabled": false}}`),
+					),
+				)
+			})
+			It("should return the updated rule", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedRule.Enabled).To(BeFalse())
+			})
+		})
+		Context("when the rule is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/network_control/3/rules/4", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrNetworkControlRuleNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrNetworkControlRuleNotFound))
+			})
+		})
+	})
+
+	Context("deleting a network control rule", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteNetworkControlRule(ctx, 3, 4)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/network_control/3/rules/4", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the rule is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/network_control/3/rules/4", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrNetworkControlRuleNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrNetworkControlRuleNotFound))
+			})
+		})
+	})
 })
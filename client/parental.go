@@ -0,0 +1,165 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeParentalNotFound = "noent"
+)
+
+// GetParentalConfig returns the global parental filter configuration.
+func (c *client) GetParentalConfig(ctx context.Context) (config types.ParentalConfig, err error) {
+	response, err := c.get(ctx, "parental/config/", c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to GET parental/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get parental config from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// UpdateParentalConfig updates the global parental filter configuration.
+func (c *client) UpdateParentalConfig(ctx context.Context, payload types.ParentalConfig) (config types.ParentalConfig, err error) {
+	response, err := c.put(ctx, "parental/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to PUT parental/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get updated parental config from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// ListParentalFilters returns every parental filter rule.
+func (c *client) ListParentalFilters(ctx context.Context) ([]types.ParentalFilter, error) {
+	response, err := c.get(ctx, "parental/filter/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET parental/filter/ endpoint: %w", err)
+	}
+
+	result := make([]types.ParentalFilter, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get parental filters from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetParentalFilter returns the parental filter rule with the given identifier.
+func (c *client) GetParentalFilter(ctx context.Context, identifier int64) (filter types.ParentalFilter, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("parental/filter/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeParentalNotFound {
+			return filter, ErrParentalFilterNotFound
+		}
+
+		return filter, fmt.Errorf("failed to GET parental/filter/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &filter); err != nil {
+		return filter, fmt.Errorf("failed to get parental filter from generic response: %w", err)
+	}
+
+	return filter, nil
+}
+
+// CreateParentalFilter creates a new parental filter rule.
+func (c *client) CreateParentalFilter(ctx context.Context, payload types.ParentalFilterPayload) (filter types.ParentalFilter, err error) {
+	response, err := c.post(ctx, "parental/filter/", payload, c.withSession(ctx))
+	if err != nil {
+		return filter, fmt.Errorf("failed to POST parental/filter/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &filter); err != nil {
+		return filter, fmt.Errorf("failed to get created parental filter from generic response: %w", err)
+	}
+
+	return filter, nil
+}
+
+// UpdateParentalFilter updates the parental filter rule with the given identifier.
+func (c *client) UpdateParentalFilter(
+	ctx context.Context,
+	identifier int64,
+	payload types.ParentalFilterPayload,
+) (filter types.ParentalFilter, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("parental/filter/%d", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeParentalNotFound {
+			return filter, ErrParentalFilterNotFound
+		}
+
+		return filter, fmt.Errorf("failed to PUT parental/filter/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &filter); err != nil {
+		return filter, fmt.Errorf("failed to get updated parental filter from generic response: %w", err)
+	}
+
+	return filter, nil
+}
+
+// DeleteParentalFilter deletes the parental filter rule with the given identifier.
+func (c *client) DeleteParentalFilter(ctx context.Context, identifier int64) error {
+	response, err := c.delete(ctx, fmt.Sprintf("parental/filter/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeParentalNotFound {
+			return ErrParentalFilterNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE parental/filter/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// GetParentalFilterPlanning returns the weekly planning of the parental filter rule with the given identifier.
+func (c *client) GetParentalFilterPlanning(ctx context.Context, identifier int64) (planning types.ParentalFilterPlanning, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("parental/filter/%d/planning", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeParentalNotFound {
+			return planning, ErrParentalFilterNotFound
+		}
+
+		return planning, fmt.Errorf("failed to GET parental/filter/%d/planning endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &planning); err != nil {
+		return planning, fmt.Errorf("failed to get parental filter planning from generic response: %w", err)
+	}
+
+	return planning, nil
+}
+
+// UpdateParentalFilterPlanning updates the weekly planning of the parental filter rule with the given identifier.
+func (c *client) UpdateParentalFilterPlanning(
+	ctx context.Context,
+	identifier int64,
+	payload types.ParentalFilterPlanning,
+) (planning types.ParentalFilterPlanning, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("parental/filter/%d/planning", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeParentalNotFound {
+			return planning, ErrParentalFilterNotFound
+		}
+
+		return planning, fmt.Errorf("failed to PUT parental/filter/%d/planning endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &planning); err != nil {
+		return planning, fmt.Errorf("failed to get updated parental filter planning from generic response: %w", err)
+	}
+
+	return planning, nil
+}
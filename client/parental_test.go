@@ -0,0 +1,412 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("parental", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("getting the parental configuration", func() {
+		returnedConfig := new(types.ParentalConfig)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetParentalConfig(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"default_filter_mode": "allowed"}}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.ParentalConfig{DefaultFilterMode: types.RuleModeAllowed}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the parental configuration", func() {
+		returnedConfig := new(types.ParentalConfig)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateParentalConfig(ctx, types.ParentalConfig{DefaultFilterMode: types.RuleModeDenied})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/parental/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"default_filter_mode": "denied"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"default_filter_mode": "denied"}}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.DefaultFilterMode).To(Equal(types.RuleModeDenied))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing parental filters", func() {
+		returnedFilters := new([]types.ParentalFilter)
+		JustBeforeEach(func() {
+			*returnedFilters, *returnedErr = freeboxClient.ListParentalFilters(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/filter/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"macs": ["00:11:22:33:44:55"],
+									"desc": "kids",
+									"forced": false,
+									"forced_mode": "denied",
+									"tmp_mode": "allowed",
+									"tmp_mode_expire": 1700000000,
+									"current_mode": "allowed",
+									"scheduled_mode": "denied"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct filters", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedFilters).To(Equal([]types.ParentalFilter{{
+					ID:            1,
+					CurrentMode:   types.RuleModeAllowed,
+					ScheduledMode: types.RuleModeDenied,
+					ParentalFilterPayload: types.ParentalFilterPayload{
+						Macs:          []string{"00:11:22:33:44:55"},
+						Description:   "kids",
+						ForcedMode:    types.RuleModeDenied,
+						TmpMode:       types.RuleModeAllowed,
+						TmpModeExpire: &types.Timestamp{Time: time.Unix(1700000000, 0).UTC()},
+					},
+				}}))
+			})
+		})
+		Context("when there are no filters", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/filter/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedFilters).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a parental filter", func() {
+		returnedFilter := new(types.ParentalFilter)
+		JustBeforeEach(func() {
+			*returnedFilter, *returnedErr = freeboxClient.GetParentalFilter(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/filter/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 1, "desc": "kids", "forced": true, "forced_mode": "webonly"}}`),
+					),
+				)
+			})
+			It("should return the correct filter", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFilter.Forced).To(BeTrue())
+				Expect(returnedFilter.ForcedMode).To(Equal(types.RuleModeWebOnly))
+				Expect(returnedFilter.TmpModeExpire).To(BeNil())
+			})
+		})
+		Context("when the filter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/filter/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrParentalFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrParentalFilterNotFound))
+			})
+		})
+	})
+
+	Context("creating a parental filter", func() {
+		returnedFilter := new(types.ParentalFilter)
+		JustBeforeEach(func() {
+			*returnedFilter, *returnedErr = freeboxClient.CreateParentalFilter(ctx, types.ParentalFilterPayload{
+				Macs:        []string{"00:11:22:33:44:55"},
+				Description: "kids",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/parental/filter/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"macs": ["00:11:22:33:44:55"], "desc": "kids", "forced": false}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 2, "macs": ["00:11:22:33:44:55"], "desc": "kids"}}`),
+					),
+				)
+			})
+			It("should return the created filter", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFilter.ID).To(Equal(int64(2)))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a parental filter", func() {
+		returnedFilter := new(types.ParentalFilter)
+		JustBeforeEach(func() {
+			*returnedFilter, *returnedErr = freeboxClient.UpdateParentalFilter(ctx, 2, types.ParentalFilterPayload{
+				Macs:       []string{"00:11:22:33:44:55"},
+				Forced:     true,
+				ForcedMode: types.RuleModeDenied,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/parental/filter/2", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"macs": ["00:11:22:33:44:55"], "desc": "", "forced": true, "forced_mode": "denied"}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 2, "forced": true, "forced_mode": "denied", "current_mode": "denied"}}`),
+					),
+				)
+			})
+			It("should return the updated filter", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFilter.CurrentMode).To(Equal(types.RuleModeDenied))
+			})
+		})
+		Context("when the filter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/parental/filter/2", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrParentalFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrParentalFilterNotFound))
+			})
+		})
+	})
+
+	Context("deleting a parental filter", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteParentalFilter(ctx, 2)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/parental/filter/2", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the filter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/parental/filter/2", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrParentalFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrParentalFilterNotFound))
+			})
+		})
+	})
+
+	Context("getting a parental filter planning", func() {
+		returnedPlanning := new(types.ParentalFilterPlanning)
+		JustBeforeEach(func() {
+			*returnedPlanning, *returnedErr = freeboxClient.GetParentalFilterPlanning(ctx, 2)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				mapping := `"` + strings.Repeat(`denied", "`, 7) + `denied"`
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/filter/2/planning", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 2, "resolution": 1, "mapping": [`+mapping+`], "cdayranges": [":fr_bank_holidays"]}
+						}`),
+					),
+				)
+			})
+			It("should return the correct planning", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedPlanning.Resolution).To(Equal(int64(1)))
+				Expect(returnedPlanning.Mapping).To(HaveLen(8))
+				Expect(returnedPlanning.CustomDayRanges).To(Equal([]types.DayRange{types.DayRangeFrenchBankHolidays}))
+				Expect(returnedPlanning.Mode(time.Wednesday, time.Hour)).To(Equal(types.RuleModeDenied))
+			})
+		})
+		Context("when the filter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/parental/filter/2/planning", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrParentalFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrParentalFilterNotFound))
+			})
+		})
+	})
+
+	Context("updating a parental filter planning", func() {
+		returnedPlanning := new(types.ParentalFilterPlanning)
+		JustBeforeEach(func() {
+			planning := types.NewParentalFilterPlanning(2, types.RuleModeAllowed)
+			Expect(planning.SetMode(time.Sunday, 12*time.Hour, 24*time.Hour, types.RuleModeDenied)).To(Succeed())
+			*returnedPlanning, *returnedErr = freeboxClient.UpdateParentalFilterPlanning(ctx, 2, planning)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				body := `{
+					"resolution": 2,
+					"mapping": [` + strings.Repeat(`"allowed", `, 13) + `"denied"]
+				}`
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/parental/filter/2/planning", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(body),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 2, "resolution": 2, "mapping": [`+strings.Repeat(`"allowed", `, 13)+`"denied"]}}`),
+					),
+				)
+			})
+			It("should return the updated planning", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedPlanning.ID).To(Equal(int64(2)))
+				Expect(returnedPlanning.Mode(time.Sunday, 13*time.Hour)).To(Equal(types.RuleModeDenied))
+			})
+		})
+		Context("when the filter is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/parental/filter/2/planning", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrParentalFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrParentalFilterNotFound))
+			})
+		})
+	})
+})
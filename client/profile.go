@@ -3,10 +3,15 @@ package client
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/nikolalohinski/free-go/types"
 )
 
+const (
+	codeProfileNotFound = "noent"
+)
+
 func (c *client) ListProfiles(ctx context.Context) (result []types.Profile, err error) {
 	response, err := c.get(ctx, "profile/", c.withSession(ctx))
 	if err != nil {
@@ -23,3 +28,46 @@ func (c *client) ListProfiles(ctx context.Context) (result []types.Profile, err
 
 	return result, nil
 }
+
+func (c *client) CreateProfile(ctx context.Context, payload types.ProfilePayload) (result types.Profile, err error) {
+	response, err := c.post(ctx, "profile/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to POST profile/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to create profile from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateProfile(ctx context.Context, identifier types.ProfileID, payload types.ProfilePayload) (result types.Profile, err error) {
+	response, err := c.put(ctx, "profile/"+strconv.FormatInt(identifier, 10), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeProfileNotFound {
+			return result, ErrProfileNotFound
+		}
+
+		return result, fmt.Errorf("failed to PUT profile/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update profile from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) DeleteProfile(ctx context.Context, identifier types.ProfileID) error {
+	response, err := c.delete(ctx, "profile/"+strconv.FormatInt(identifier, 10), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeProfileNotFound {
+			return ErrProfileNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE profile/%d endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
@@ -113,4 +113,115 @@ var _ = Describe("profile", func() {
 			})
 		})
 	})
+	Context("creating a profile", func() {
+		returnedProfile := new(types.Profile)
+		JustBeforeEach(func() {
+			*returnedProfile, *returnedErr = freeboxClient.CreateProfile(ctx, types.ProfilePayload{
+				Name: "Pierre",
+				Icon: "/resources/images/profile/profile_02.png",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/profile/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"name": "Pierre", "icon": "/resources/images/profile/profile_02.png"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 3, "name": "Pierre", "icon": "/resources/images/profile/profile_02.png"}
+						}`),
+					),
+				)
+			})
+			It("should return the created profile", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedProfile).To(Equal(types.Profile{
+					ID:   3,
+					Name: "Pierre",
+					Icon: "/resources/images/profile/profile_02.png",
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a profile", func() {
+		returnedProfile := new(types.Profile)
+		JustBeforeEach(func() {
+			*returnedProfile, *returnedErr = freeboxClient.UpdateProfile(ctx, 3, types.ProfilePayload{Name: "Paul"})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/profile/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"name": "Paul", "icon": ""}`),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"id": 3, "name": "Paul"}}`),
+					),
+				)
+			})
+			It("should return the updated profile", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedProfile.Name).To(Equal("Paul"))
+			})
+		})
+		Context("when the profile is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/profile/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrProfileNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrProfileNotFound))
+			})
+		})
+	})
+
+	Context("deleting a profile", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteProfile(ctx, 3)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/profile/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the profile is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/profile/3", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrProfileNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrProfileNotFound))
+			})
+		})
+	})
 })
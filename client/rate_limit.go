@@ -0,0 +1,114 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const codeRateLimited = "ratelimited"
+
+// rateLimiter is a token bucket shared by every request of the client.
+// When the box answers ratelimited, the bucket goes into debt so that the following requests are delayed.
+type rateLimiter struct {
+	mutex sync.Mutex
+
+	rate    float64 // tokens added per second
+	burst   float64
+	tokens  float64 // negative when requests are already waiting for a token
+	last    time.Time
+	backoff time.Duration // penalty applied on the next ratelimited answer, doubled every time
+}
+
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	return &rateLimiter{
+		rate:   rps,
+		burst:  math.Max(float64(burst), 1),
+		tokens: math.Max(float64(burst), 1),
+		last:   time.Now(),
+	}
+}
+
+// wait blocks until the request is allowed to be sent.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mutex.Lock()
+	now := time.Now()
+	l.tokens = math.Min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mutex.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	if err := wait(ctx, delay); err != nil {
+		l.mutex.Lock()
+		l.tokens++
+		l.mutex.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// penalize delays the next requests after the box answered ratelimited.
+func (l *rateLimiter) penalize() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.backoff == 0 {
+		l.backoff = RateLimitBackoff
+	}
+
+	l.tokens = math.Min(l.tokens, 0) - l.backoff.Seconds()*l.rate
+	l.backoff = min(l.backoff*2, RateLimitMaxBackoff)
+}
+
+// relax resets the penalty once the box accepts requests again.
+func (l *rateLimiter) relax() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.backoff = 0
+}
+
+// acquire waits for the rate limit and for a free request slot, and returns the function releasing the slot.
+// The rate limit is waited for first, so that requests waiting out a back off do not hold slots.
+func (c *client) acquire(ctx context.Context) (release func(), err error) {
+	if c.rateLimiter != nil {
+		if err := c.rateLimiter.wait(ctx); err != nil {
+			return nil, fmt.Errorf("failed to wait for the rate limit: %w", err)
+		}
+	}
+
+	if c.requestSlots == nil {
+		return func() {}, nil
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil, fmt.Errorf("failed to wait for a free request slot: %w", ctx.Err())
+	case c.requestSlots <- struct{}{}:
+	}
+
+	return func() { <-c.requestSlots }, nil
+}
+
+// observeRateLimit adjusts the rate limiter to the outcome of a request.
+func (c *client) observeRateLimit(err error) {
+	if c.rateLimiter == nil {
+		return
+	}
+
+	if errors.Is(err, &APIError{Code: codeRateLimited}) {
+		c.rateLimiter.penalize()
+	} else if err == nil {
+		c.rateLimiter.relax()
+	}
+}
@@ -0,0 +1,217 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("rate limiting", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("when the number of concurrent requests is capped", func() {
+		const (
+			maxConcurrentRequests = 3
+			goroutines            = 20
+		)
+		var (
+			inFlight    = new(atomic.Int32)
+			maxInFlight = new(atomic.Int32)
+		)
+		BeforeEach(func() {
+			inFlight.Store(0)
+			maxInFlight.Store(0)
+
+			freeboxClient.WithMaxConcurrentRequests(maxConcurrentRequests)
+
+			server.RouteToHandler(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version), func(w http.ResponseWriter, _ *http.Request) {
+				current := inFlight.Add(1)
+				defer inFlight.Add(-1)
+
+				for {
+					observed := maxInFlight.Load()
+					if current <= observed || maxInFlight.CompareAndSwap(observed, current) {
+						break
+					}
+				}
+
+				time.Sleep(time.Millisecond * 10)
+				_, _ = w.Write([]byte(`{"success": true, "result": {"enabled": true}}`))
+			})
+		})
+		It("should never send more requests at once", func() {
+			wg := sync.WaitGroup{}
+			errs := make(chan error, goroutines)
+			for range goroutines {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := freeboxClient.GetUPnPAVConfiguration(ctx)
+					errs <- err
+				}()
+			}
+			wg.Wait()
+			close(errs)
+
+			for err := range errs {
+				Expect(err).To(BeNil())
+			}
+			Expect(maxInFlight.Load()).To(BeNumerically("<=", maxConcurrentRequests))
+			Expect(maxInFlight.Load()).To(BeNumerically(">", 1))
+		})
+	})
+
+	Context("when the rate of requests is limited", func() {
+		const rps = 20
+		BeforeEach(func() {
+			freeboxClient.WithRateLimit(rps, 1)
+
+			server.RouteToHandler(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version), ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`))
+		})
+		It("should space the requests out", func() {
+			start := time.Now()
+			for range 5 {
+				_, err := freeboxClient.GetUPnPAVConfiguration(ctx)
+				Expect(err).To(BeNil())
+			}
+			// 2 login requests then 5 regular ones, the first of them being allowed by the burst
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second*6/rps))
+		})
+		It("should space the streamed requests out", func() {
+			server.RouteToHandler(http.MethodGet, fmt.Sprintf("/api/%s/api_version", version), ghttp.RespondWith(http.StatusOK, `{"api_version": "10.0"}`))
+
+			start := time.Now()
+			for range 5 {
+				_, err := freeboxClient.APIVersion(ctx)
+				Expect(err).To(BeNil())
+			}
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second*4/rps))
+		})
+		Context("when the context is canceled while waiting", func() {
+			BeforeEach(func() {
+				freeboxClient.WithRateLimit(0.001, 2)
+
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Millisecond*50)
+				DeferCleanup(cancel)
+			})
+			It("should return an error", func() {
+				_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+				Expect(*returnedErr).To(MatchError(ContainSubstring("failed to wait for the rate limit")))
+				Expect(*returnedErr).To(MatchError(context.DeadlineExceeded))
+			})
+		})
+	})
+
+	Context("when the box answers ratelimited", func() {
+		BeforeEach(func() {
+			backoff := client.RateLimitBackoff
+			client.RateLimitBackoff = time.Millisecond * 200
+			DeferCleanup(func() { client.RateLimitBackoff = backoff })
+
+			freeboxClient.WithRateLimit(1000, 10)
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusTooManyRequests, `{"success": false, "error_code": "ratelimited", "msg": "Too many requests"}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`),
+				),
+			)
+		})
+		It("should back off before sending the next request", func() {
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+			Expect(*returnedErr).To(MatchError(&client.APIError{Code: "ratelimited"}))
+
+			start := time.Now()
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+			Expect(*returnedErr).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Millisecond*150))
+		})
+		It("should back off when a raw call is answered ratelimited too", func() {
+			server.SetHandler(2, ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/vpn/download_config/openvpn_routed/perreux", version)),
+				verifyAuth(*sessionToken),
+				ghttp.RespondWith(http.StatusOK, `{"success": false, "error_code": "ratelimited"}`, http.Header{"Content-Type": []string{"application/json"}}),
+			))
+
+			_, *returnedErr = freeboxClient.GetVPNUserClientConfig(ctx, "perreux")
+			Expect(*returnedErr).To(MatchError(&client.APIError{Code: "ratelimited"}))
+
+			start := time.Now()
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+			Expect(*returnedErr).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Millisecond*150))
+		})
+		It("should back off when a websocket handshake is answered ratelimited too", func() {
+			server.SetHandler(2, ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/ws/event", version)),
+				verifyAuth(*sessionToken),
+				ghttp.RespondWith(http.StatusTooManyRequests, `{"success": false, "error_code": "ratelimited"}`),
+			))
+
+			_, *returnedErr = freeboxClient.ListenEvents(ctx, []types.EventDescription{{Source: "lan_host", Name: "l3addr_reachable"}})
+			Expect(*returnedErr).To(MatchError(&client.APIError{Code: "ratelimited"}))
+
+			start := time.Now()
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+			Expect(*returnedErr).To(BeNil())
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Millisecond*150))
+		})
+		Context("when a retry policy is set", func() {
+			BeforeEach(func() {
+				policy := client.NewExponentialBackoff()
+				policy.InitialInterval = time.Millisecond
+				freeboxClient.WithRetryPolicy(policy)
+			})
+			It("should retry the request once the back off is over", func() {
+				start := time.Now()
+				_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+				Expect(*returnedErr).To(BeNil())
+				Expect(time.Since(start)).To(BeNumerically(">=", time.Millisecond*150))
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+			})
+		})
+	})
+})
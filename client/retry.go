@@ -0,0 +1,168 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// RetryAttempt describes a failed request for a RetryPolicy to decide upon.
+type RetryAttempt struct {
+	Number     int    // number of the retry about to be attempted, starting at 1
+	Method     string // HTTP method of the failed request
+	Err        error  // error returned by the failed request
+	StatusCode int    // HTTP status of the failed request, zero when it failed before getting a response
+	Code       string // error code returned by the API, empty when the request failed before getting one
+}
+
+// RetryPolicy decides whether a failed request should be attempted again, and after how long.
+type RetryPolicy interface {
+	Retry(attempt RetryAttempt) (delay time.Duration, retry bool)
+}
+
+// ExponentialBackoff is a RetryPolicy doubling, by default, the delay between each retry. It only retries
+// transient failures: requests that did not get a response, server errors, and the given API error codes.
+type ExponentialBackoff struct {
+	MaxRetries      int
+	InitialInterval time.Duration
+	MaxInterval     time.Duration
+	Multiplier      float64
+	Jitter          float64  // fraction of the delay randomly added or removed, between 0 and 1
+	RetryPOST       bool     // POST requests are not idempotent, so they are only retried on demand
+	RetriedCodes    []string // API error codes that may go away by retrying
+}
+
+// NewExponentialBackoff returns an ExponentialBackoff with sensible defaults for a box reached over Wi-Fi.
+func NewExponentialBackoff() *ExponentialBackoff {
+	return &ExponentialBackoff{
+		MaxRetries:      3,
+		InitialInterval: time.Millisecond * 200,
+		MaxInterval:     time.Second * 5,
+		Multiplier:      2,
+		Jitter:          0.5,
+		RetriedCodes:    []string{codeRateLimited},
+	}
+}
+
+func (b *ExponentialBackoff) Retry(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Number > b.MaxRetries {
+		return 0, false
+	}
+
+	switch attempt.Method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+	case http.MethodPost:
+		if !b.RetryPOST {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+
+	statusError := new(StatusError)
+
+	switch {
+	case attempt.Code != "":
+		if !slices.Contains(b.RetriedCodes, attempt.Code) {
+			return 0, false
+		}
+	case errors.As(attempt.Err, &statusError):
+		if statusError.StatusCode < http.StatusInternalServerError {
+			return 0, false
+		}
+	case attempt.StatusCode != 0:
+		// The box answered, but with something that will not change by asking again
+		return 0, false
+	}
+
+	delay := float64(b.InitialInterval) * math.Pow(b.Multiplier, float64(attempt.Number-1))
+	if b.MaxInterval > 0 {
+		delay = math.Min(delay, float64(b.MaxInterval))
+	}
+
+	if b.Jitter > 0 {
+		delay += delay * b.Jitter * (2*rand.Float64() - 1) //nolint:gosec
+	}
+
+	return time.Duration(delay), true
+}
+
+// sendWithRetries sends the request, then sends it again for as long as the retry policy asks to.
+func (c *client) sendWithRetries(call *Call, request *http.Request) (*genericResponse, error) {
+	response, err := c.send(call, request)
+	if c.retryPolicy == nil {
+		return response, err
+	}
+
+	for number := 1; err != nil && !isSessionError(err) && request.Context().Err() == nil; number++ {
+		attempt := RetryAttempt{
+			Number: number,
+			Method: request.Method,
+			Err:    err,
+		}
+
+		if call.Response != nil {
+			attempt.StatusCode = call.Response.StatusCode
+		}
+
+		apiError := new(APIError)
+		if errors.As(err, &apiError) {
+			attempt.Code = apiError.Code
+		}
+
+		delay, retry := c.retryPolicy.Retry(attempt)
+		if !retry {
+			break
+		}
+
+		if waitErr := wait(request.Context(), delay); waitErr != nil {
+			return response, errors.Join(err, fmt.Errorf("gave up retrying request: %w", waitErr))
+		}
+
+		replay, rewindErr := rewind(request)
+		if rewindErr != nil {
+			return response, errors.Join(err, rewindErr)
+		}
+
+		response, err = c.send(call, replay)
+	}
+
+	return response, err
+}
+
+// rewind returns a copy of the request that can be sent again.
+func rewind(request *http.Request) (*http.Request, error) {
+	replay := request.Clone(request.Context())
+
+	if request.Body != nil && request.Body != http.NoBody {
+		if request.GetBody == nil {
+			return nil, errors.New("failed to replay request: its body can not be rewound")
+		}
+
+		body, err := request.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewind request body: %w", err)
+		}
+
+		replay.Body = body
+	}
+
+	return replay, nil
+}
+
+func wait(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint:wrapcheck
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,308 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("retry", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		policy *client.ExponentialBackoff
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		policy = client.NewExponentialBackoff()
+		policy.InitialInterval = time.Millisecond
+		policy.Jitter = 0
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken).
+			WithRetryPolicy(policy)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("exponential backoff", func() {
+		BeforeEach(func() {
+			policy.InitialInterval = time.Second
+			policy.MaxInterval = time.Second * 3
+		})
+		It("should double the delay between each retry up to the maximum interval", func() {
+			for number, expected := range []time.Duration{time.Second, time.Second * 2, time.Second * 3} {
+				delay, retry := policy.Retry(client.RetryAttempt{Number: number + 1, Method: http.MethodGet})
+				Expect(retry).To(BeTrue())
+				Expect(delay).To(Equal(expected))
+			}
+		})
+		It("should give up after the maximum number of retries", func() {
+			_, retry := policy.Retry(client.RetryAttempt{Number: policy.MaxRetries + 1, Method: http.MethodGet})
+			Expect(retry).To(BeFalse())
+		})
+		It("should only retry POST requests on demand", func() {
+			_, retry := policy.Retry(client.RetryAttempt{Number: 1, Method: http.MethodPost})
+			Expect(retry).To(BeFalse())
+
+			policy.RetryPOST = true
+			_, retry = policy.Retry(client.RetryAttempt{Number: 1, Method: http.MethodPost})
+			Expect(retry).To(BeTrue())
+		})
+		It("should only retry transient API errors", func() {
+			for _, code := range []string{"noent", "inval", "exists", "denied", "insufficient_rights", "auth_required"} {
+				_, retry := policy.Retry(client.RetryAttempt{Number: 1, Method: http.MethodGet, StatusCode: http.StatusForbidden, Code: code})
+				Expect(retry).To(BeFalse(), code)
+			}
+
+			_, retry := policy.Retry(client.RetryAttempt{Number: 1, Method: http.MethodGet, StatusCode: http.StatusTooManyRequests, Code: "ratelimited"})
+			Expect(retry).To(BeTrue())
+		})
+		It("should only retry server errors", func() {
+			_, retry := policy.Retry(client.RetryAttempt{
+				Number:     1,
+				Method:     http.MethodGet,
+				StatusCode: http.StatusBadGateway,
+				Err:        &client.StatusError{StatusCode: http.StatusBadGateway},
+			})
+			Expect(retry).To(BeTrue())
+
+			_, retry = policy.Retry(client.RetryAttempt{
+				Number:     1,
+				Method:     http.MethodGet,
+				StatusCode: http.StatusOK,
+				Err:        errors.New("failed to unmarshal response body"),
+			})
+			Expect(retry).To(BeFalse())
+		})
+		It("should keep the delay within the jitter", func() {
+			policy.Jitter = 0.5
+			for range 100 {
+				delay, _ := policy.Retry(client.RetryAttempt{Number: 1, Method: http.MethodGet})
+				Expect(delay).To(BeNumerically("~", time.Second, time.Second/2))
+			}
+		})
+	})
+
+	Context("when a GET request fails with a server error", func() {
+		returnedConfig := new(types.UPnPAVConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+		})
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					ghttp.RespondWith(http.StatusBadGateway, "bad gateway"),
+				),
+			)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`),
+					),
+				)
+			})
+			It("should retry the request", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.UPnPAVConfiguration{Enabled: true}))
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+			})
+		})
+		Context("when the request keeps failing", func() {
+			BeforeEach(func() {
+				for range policy.MaxRetries {
+					server.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+							ghttp.RespondWith(http.StatusBadGateway, "bad gateway"),
+						),
+					)
+				}
+			})
+			It("should return the last error once the retries are exhausted", func() {
+				statusError := new(client.StatusError)
+				Expect(errors.As(*returnedErr, &statusError)).To(BeTrue())
+				Expect(statusError.StatusCode).To(Equal(http.StatusBadGateway))
+				Expect(server.ReceivedRequests()).To(HaveLen(3 + policy.MaxRetries))
+			})
+		})
+		Context("when the context is canceled while waiting", func() {
+			BeforeEach(func() {
+				policy.InitialInterval = time.Hour
+
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, time.Millisecond*50)
+				DeferCleanup(cancel)
+			})
+			It("should stop retrying", func() {
+				Expect(*returnedErr).To(MatchError(context.DeadlineExceeded))
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+	})
+
+	Context("when the connection fails", func() {
+		BeforeEach(func() {
+			freeboxClient.WithHTTPClient(&flakyHTTPClient{failures: 1, path: "/upnpav/config/"})
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+		})
+		It("should retry the request", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+	})
+
+	Context("when a PUT request fails with a server error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					ghttp.VerifyJSON(`{"enabled": true}`),
+					ghttp.RespondWith(http.StatusServiceUnavailable, ""),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.VerifyJSON(`{"enabled": true}`),
+					ghttp.RespondWith(http.StatusOK, `{"success": true, "result": {"enabled": true}}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			_, *returnedErr = freeboxClient.UpdateUPnPAVConfiguration(ctx, types.UPnPAVConfiguration{Enabled: true})
+		})
+		It("should retry the request with the same body", func() {
+			Expect(*returnedErr).To(BeNil())
+			Expect(server.ReceivedRequests()).To(HaveLen(4))
+		})
+	})
+
+	Context("when a POST request fails with a server error", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/system/reboot/", version)),
+					ghttp.RespondWith(http.StatusBadGateway, ""),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.Reboot(ctx)
+		})
+		Context("default", func() {
+			It("should not retry the request", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+				Expect(server.ReceivedRequests()).To(HaveLen(3))
+			})
+		})
+		Context("when POST requests are retried on demand", func() {
+			BeforeEach(func() {
+				policy.RetryPOST = true
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/system/reboot/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should retry the request", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(server.ReceivedRequests()).To(HaveLen(4))
+			})
+		})
+	})
+
+	Context("when a GET request is denied", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/upnpav/config/", version)),
+					ghttp.RespondWith(http.StatusForbidden, `{"success": false, "error_code": "insufficient_rights"}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			_, *returnedErr = freeboxClient.GetUPnPAVConfiguration(ctx)
+		})
+		It("should not retry the request", func() {
+			Expect(*returnedErr).To(MatchError(&client.APIError{Code: "insufficient_rights"}))
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+	})
+
+	Context("when a request fails with a permanent error code", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/share_link/token", version)),
+					ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteShareLink(ctx, "token")
+		})
+		It("should not retry the request", func() {
+			Expect(*returnedErr).To(MatchError(client.ErrShareLinkNotFound))
+			Expect(server.ReceivedRequests()).To(HaveLen(3))
+		})
+	})
+})
+
+// flakyHTTPClient fails the first requests sent to the given path as if the connection was reset.
+type flakyHTTPClient struct {
+	failures int
+	path     string
+}
+
+func (f *flakyHTTPClient) Do(request *http.Request) (*http.Response, error) {
+	if f.failures > 0 && strings.HasSuffix(request.URL.Path, f.path) {
+		f.failures--
+
+		return nil, errors.New("connection reset by peer")
+	}
+
+	return http.DefaultClient.Do(request) //nolint:wrapcheck
+}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeShareLinkNotFound = "noent"
+)
+
+func (c *client) ListShareLinks(ctx context.Context) ([]types.ShareLink, error) {
+	response, err := c.get(ctx, "share_link/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET share_link/ endpoint: %w", err)
+	}
+
+	result := make([]types.ShareLink, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to list share links from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *client) GetShareLink(ctx context.Context, token string) (result types.ShareLink, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("share_link/%s", token), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeShareLinkNotFound {
+			return result, ErrShareLinkNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET share_link/%s endpoint: %w", token, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get share link from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) CreateShareLink(ctx context.Context, payload types.ShareLinkPayload) (result types.ShareLink, err error) {
+	response, err := c.post(ctx, "share_link/", payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == pathNotFoundCode {
+			return result, ErrPathNotFound
+		}
+
+		return result, fmt.Errorf("failed to POST share_link/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get share link from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+func (c *client) DeleteShareLink(ctx context.Context, token string) error {
+	response, err := c.delete(ctx, fmt.Sprintf("share_link/%s", token), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeShareLinkNotFound {
+			return ErrShareLinkNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE share_link/%s endpoint: %w", token, err)
+	}
+
+	return nil
+}
+
+// PruneExpiredShareLinks deletes every share link that has expired and returns the deleted links.
+// Links that disappear in the meantime are not considered as an error.
+func (c *client) PruneExpiredShareLinks(ctx context.Context) ([]types.ShareLink, error) {
+	links, err := c.ListShareLinks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list share links: %w", err)
+	}
+
+	now := time.Now()
+	pruned := make([]types.ShareLink, 0)
+
+	for _, link := range links {
+		if !link.Expired(now) {
+			continue
+		}
+
+		if err = c.DeleteShareLink(ctx, link.Token); err != nil && !errors.Is(err, ErrShareLinkNotFound) {
+			return pruned, fmt.Errorf("failed to delete share link %s: %w", link.Token, err)
+		}
+
+		pruned = append(pruned, link)
+	}
+
+	return pruned, nil
+}
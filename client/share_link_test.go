@@ -0,0 +1,343 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("share links", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("listing share links", func() {
+		returnedLinks := new([]types.ShareLink)
+		JustBeforeEach(func() {
+			*returnedLinks, *returnedErr = freeboxClient.ListShareLinks(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/share_link/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"token": "ssJ1wBDm4ywPuTvD",
+									"path": "L0ZyZWVib3gvUGhvdG9z",
+									"name": "Photos",
+									"expire": 0,
+									"fullurl": "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct share links", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLinks).To(Equal([]types.ShareLink{
+					{
+						Token:   "ssJ1wBDm4ywPuTvD",
+						Path:    "/Freebox/Photos",
+						Name:    "Photos",
+						FullURL: "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD",
+					},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/share_link/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLinks).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a share link", func() {
+		returnedLink := new(types.ShareLink)
+		JustBeforeEach(func() {
+			*returnedLink, *returnedErr = freeboxClient.GetShareLink(ctx, "ssJ1wBDm4ywPuTvD")
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/share_link/ssJ1wBDm4ywPuTvD", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"token": "ssJ1wBDm4ywPuTvD",
+								"path": "L0ZyZWVib3gvVmlkZW9zL2ZpbG0ubWt2",
+								"name": "film.mkv",
+								"expire": 1663485940,
+								"fullurl": "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct share link", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLink).To(Equal(types.ShareLink{
+					Token:   "ssJ1wBDm4ywPuTvD",
+					Path:    "/Freebox/Videos/film.mkv",
+					Name:    "film.mkv",
+					Expire:  time.Unix(1663485940, 0).UTC(),
+					FullURL: "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD",
+				}))
+			})
+		})
+		Context("when the share link is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/share_link/ssJ1wBDm4ywPuTvD", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrShareLinkNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrShareLinkNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("creating a share link", func() {
+		returnedLink := new(types.ShareLink)
+		JustBeforeEach(func() {
+			*returnedLink, *returnedErr = freeboxClient.CreateShareLink(ctx, types.ShareLinkPayload{
+				Path:   "/Freebox/Videos/film.mkv",
+				Expire: time.Unix(1663485940, 0),
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/share_link/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"path": "L0ZyZWVib3gvVmlkZW9zL2ZpbG0ubWt2", "expire": 1663485940}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"token": "ssJ1wBDm4ywPuTvD",
+								"path": "L0ZyZWVib3gvVmlkZW9zL2ZpbG0ubWt2",
+								"name": "film.mkv",
+								"expire": 1663485940,
+								"fullurl": "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the created share link", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedLink.Token).To(Equal("ssJ1wBDm4ywPuTvD"))
+				Expect(returnedLink.Expire).To(Equal(time.Unix(1663485940, 0).UTC()))
+			})
+		})
+		Context("when the path is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/share_link/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "path_not_found"}`),
+					),
+				)
+			})
+			It("should return ErrPathNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrPathNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("deleting a share link", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteShareLink(ctx, "ssJ1wBDm4ywPuTvD")
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/share_link/ssJ1wBDm4ywPuTvD", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the share link is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/share_link/ssJ1wBDm4ywPuTvD", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrShareLinkNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrShareLinkNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("pruning expired share links", func() {
+		returnedLinks := new([]types.ShareLink)
+		JustBeforeEach(func() {
+			*returnedLinks, *returnedErr = freeboxClient.PruneExpiredShareLinks(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/share_link/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{
+							"success": true,
+							"result": [
+								{"token": "expired", "path": "L0ZyZWVib3gvUGhvdG9z", "expire": 1663485940},
+								{"token": "never", "path": "L0ZyZWVib3gvUGhvdG9z", "expire": 0},
+								{"token": "later", "path": "L0ZyZWVib3gvUGhvdG9z", "expire": %d},
+								{"token": "gone", "path": "L0ZyZWVib3gvUGhvdG9z", "expire": 1663485941}
+							]
+						}`, time.Now().Add(time.Hour).Unix())),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/share_link/expired", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/share_link/gone", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should delete the expired share links only", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedLinks).To(HaveLen(2))
+				Expect((*returnedLinks)[0].Token).To(Equal("expired"))
+				Expect((*returnedLinks)[1].Token).To(Equal("gone"))
+				Expect(server.ReceivedRequests()).To(HaveLen(5))
+			})
+		})
+		Context("when a deletion fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/share_link/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{"token": "expired", "path": "L0ZyZWVib3gvUGhvdG9z", "expire": 1663485940}
+							]
+						}`),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/share_link/expired", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusInternalServerError, `{"success": false, "error_code": "internal_error"}`),
+					),
+				)
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+				Expect(*returnedLinks).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -43,8 +43,10 @@ func verifyAuth(sessionToken string) http.HandlerFunc {
 }
 
 func setupLoginFlow(server *ghttp.Server) string {
-	sessionToken := "EfETzVibY7K5vZVsq+MjtD6pDJoAaYQiqyXwS5kFvooTczPMk7Tz+6//aTe9zZNy"
+	return setupLoginFlowWithToken(server, "EfETzVibY7K5vZVsq+MjtD6pDJoAaYQiqyXwS5kFvooTczPMk7Tz+6//aTe9zZNy")
+}
 
+func setupLoginFlowWithToken(server *ghttp.Server, sessionToken string) string {
 	server.AppendHandlers(
 		ghttp.CombineHandlers(
 			ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/login", version)),
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeSwitchPortNotFound = "noent"
+)
+
+// GetSwitchStatus returns the status of every port of the switch, including its link state.
+func (c *client) GetSwitchStatus(ctx context.Context) ([]types.SwitchPortStatus, error) {
+	response, err := c.get(ctx, "switch/status/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET switch/status/ endpoint: %w", err)
+	}
+
+	result := make([]types.SwitchPortStatus, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get switch status from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetSwitchPortConfiguration returns the configuration of a switch port.
+func (c *client) GetSwitchPortConfiguration(ctx context.Context, identifier int64) (result types.SwitchPortConfiguration, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("switch/port/%d/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeSwitchPortNotFound {
+			return result, ErrSwitchPortNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET switch/port/%d/ endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get switch port configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// UpdateSwitchPortConfiguration updates the duplex mode and speed of a switch port.
+func (c *client) UpdateSwitchPortConfiguration(ctx context.Context, identifier int64, payload types.SwitchPortConfigurationPayload) (result types.SwitchPortConfiguration, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("switch/port/%d/", identifier), payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeSwitchPortNotFound {
+			return result, ErrSwitchPortNotFound
+		}
+
+		return result, fmt.Errorf("failed to PUT switch/port/%d/ endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get switch port configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
+
+// ListSwitchPortMacs returns the devices seen on a switch port.
+func (c *client) ListSwitchPortMacs(ctx context.Context, identifier int64) ([]types.SwitchPortMac, error) {
+	response, err := c.get(ctx, fmt.Sprintf("switch/port/%d/mac_list/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeSwitchPortNotFound {
+			return nil, ErrSwitchPortNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET switch/port/%d/mac_list/ endpoint: %w", identifier, err)
+	}
+
+	result := make([]types.SwitchPortMac, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to list switch port macs from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetSwitchPortStats returns the statistics counters of a switch port.
+func (c *client) GetSwitchPortStats(ctx context.Context, identifier int64) (result types.SwitchPortStats, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("switch/port/%d/stats/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeSwitchPortNotFound {
+			return result, ErrSwitchPortNotFound
+		}
+
+		return result, fmt.Errorf("failed to GET switch/port/%d/stats/ endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to get switch port stats from generic response: %w", err)
+	}
+
+	return result, nil
+}
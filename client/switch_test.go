@@ -0,0 +1,371 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("switch", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	Context("getting the switch status", func() {
+		returnedStatus := new([]types.SwitchPortStatus)
+		JustBeforeEach(func() {
+			*returnedStatus, *returnedErr = freeboxClient.GetSwitchStatus(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/status/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 1,
+									"name": "Ethernet 1",
+									"link": "up",
+									"mode": "1000BaseT-FD",
+									"speed": "1000",
+									"duplex": "full",
+									"rrd_id": "1",
+									"mac_list": [
+										{"mac": "00:24:D4:7E:00:4C", "hostname": "freebox-player"}
+									]
+								},
+								{
+									"id": 2,
+									"name": "Ethernet 2",
+									"link": "down",
+									"mode": "",
+									"speed": "10",
+									"duplex": "half",
+									"rrd_id": "2"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct status", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStatus).To(Equal([]types.SwitchPortStatus{
+					{
+						ID:     1,
+						Name:   "Ethernet 1",
+						Link:   types.SwitchPortLinkUp,
+						Mode:   "1000BaseT-FD",
+						Speed:  types.SwitchPortSpeed1000,
+						Duplex: types.SwitchPortDuplexFull,
+						RRDID:  "1",
+						MacList: []types.SwitchPortMac{
+							{Mac: "00:24:D4:7E:00:4C", Hostname: "freebox-player"},
+						},
+					},
+					{
+						ID:     2,
+						Name:   "Ethernet 2",
+						Link:   types.SwitchPortLinkDown,
+						Speed:  types.SwitchPortSpeed10,
+						Duplex: types.SwitchPortDuplexHalf,
+						RRDID:  "2",
+					},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/status/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStatus).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a switch port configuration", func() {
+		returnedConfiguration := new(types.SwitchPortConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfiguration, *returnedErr = freeboxClient.GetSwitchPortConfiguration(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 1, "duplex": "auto", "speed": "auto"}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfiguration).To(Equal(types.SwitchPortConfiguration{
+					ID: 1,
+					SwitchPortConfigurationPayload: types.SwitchPortConfigurationPayload{
+						Duplex: types.SwitchPortDuplexAuto,
+						Speed:  types.SwitchPortSpeedAuto,
+					},
+				}))
+			})
+		})
+		Context("when the port is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrSwitchPortNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrSwitchPortNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a switch port configuration", func() {
+		returnedConfiguration := new(types.SwitchPortConfiguration)
+		JustBeforeEach(func() {
+			*returnedConfiguration, *returnedErr = freeboxClient.UpdateSwitchPortConfiguration(ctx, 1, types.SwitchPortConfigurationPayload{
+				Duplex: types.SwitchPortDuplexFull,
+				Speed:  types.SwitchPortSpeed100,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/switch/port/1/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"duplex": "full", "speed": "100"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {"id": 1, "duplex": "full", "speed": "100"}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfiguration.Duplex).To(Equal(types.SwitchPortDuplexFull))
+				Expect(returnedConfiguration.Speed).To(Equal(types.SwitchPortSpeed100))
+			})
+		})
+		Context("when the port is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/switch/port/1/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrSwitchPortNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrSwitchPortNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the MAC addresses of a switch port", func() {
+		returnedMacs := new([]types.SwitchPortMac)
+		JustBeforeEach(func() {
+			*returnedMacs, *returnedErr = freeboxClient.ListSwitchPortMacs(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/mac_list/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [{"mac": "00:24:D4:7E:00:4C", "hostname": "freebox-player"}]
+						}`),
+					),
+				)
+			})
+			It("should return the correct MAC addresses", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedMacs).To(Equal([]types.SwitchPortMac{
+					{Mac: "00:24:D4:7E:00:4C", Hostname: "freebox-player"},
+				}))
+			})
+		})
+		Context("when the result is empty", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/mac_list/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedMacs).To(BeEmpty())
+			})
+		})
+		Context("when the port is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/mac_list/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrSwitchPortNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrSwitchPortNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting the statistics of a switch port", func() {
+		returnedStats := new(types.SwitchPortStats)
+		JustBeforeEach(func() {
+			*returnedStats, *returnedErr = freeboxClient.GetSwitchPortStats(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/stats/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"rx_bytes_rate": 2048,
+								"rx_good_bytes": 123456789,
+								"rx_good_packets": 98765,
+								"rx_err_packets": 3,
+								"rx_fcs_packets": 2,
+								"tx_bytes": 987654321,
+								"tx_bytes_rate": 1024,
+								"tx_packets": 45678,
+								"tx_collisions": 1
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct statistics", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStats).To(Equal(types.SwitchPortStats{
+					RxBytesRate:   2048,
+					RxGoodBytes:   123456789,
+					RxGoodPackets: 98765,
+					RxErrPackets:  3,
+					RxFCSPackets:  2,
+					TxBytes:       987654321,
+					TxBytesRate:   1024,
+					TxPackets:     45678,
+					TxCollisions:  1,
+				}))
+			})
+		})
+		Context("when the port is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/switch/port/1/stats/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusNotFound, `{"success": false, "error_code": "noent"}`),
+					),
+				)
+			})
+			It("should return ErrSwitchPortNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrSwitchPortNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
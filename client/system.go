@@ -2,7 +2,11 @@ package client
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"syscall"
+	"time"
 
 	"github.com/nikolalohinski/free-go/types"
 )
@@ -19,3 +23,102 @@ func (c *client) GetSystemInfo(ctx context.Context) (result types.SystemConfig,
 
 	return result, nil
 }
+
+func (c *client) Reboot(ctx context.Context) error {
+	if _, err := c.post(ctx, "system/reboot/", nil, c.withSession(ctx)); err != nil {
+		return fmt.Errorf("failed to POST system/reboot/ endpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (c *client) Shutdown(ctx context.Context) error {
+	if _, err := c.post(ctx, "system/shutdown/", nil, c.withSession(ctx)); err != nil {
+		return fmt.Errorf("failed to POST system/shutdown/ endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// RebootAndWait reboots the Freebox and blocks until it answers again, or until the timeout is reached.
+// The box is considered back once it answers with an uptime lower than before the reboot, or than the time
+// elapsed since the reboot was requested. When it was seen going down, a new session is opened first since
+// the previous one does not survive the reboot. The uptime of the box is returned.
+func (c *client) RebootAndWait(ctx context.Context, timeout time.Duration) (uptime int64, err error) {
+	if timeout <= 0 {
+		return 0, fmt.Errorf("timeout must be positive, got %s", timeout)
+	}
+
+	before, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get uptime before reboot: %w", err)
+	}
+
+	start := time.Now()
+
+	if err = c.Reboot(ctx); err != nil && !isConnectionDropped(err) {
+		return 0, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var (
+		lastErr  error
+		wentDown bool
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			if lastErr != nil {
+				return 0, fmt.Errorf("%w after %s: %w", ErrRebootTimeout, timeout, lastErr)
+			}
+
+			return 0, fmt.Errorf("%w after %s", ErrRebootTimeout, timeout)
+		case <-time.After(RebootPollInterval):
+		}
+
+		if _, err := c.APIVersion(ctx); err != nil {
+			wentDown = true
+			lastErr = fmt.Errorf("freebox is not answering: %w", err)
+
+			continue
+		}
+
+		// A fast reboot may happen between two polls, the uptime tells whether it did
+		uptime, lastErr = c.getUptimeAfterReboot(ctx, wentDown)
+		if lastErr != nil {
+			continue
+		}
+
+		if uptime < before.UptimeVal || time.Duration(uptime)*time.Second < time.Since(start) {
+			return uptime, nil
+		}
+
+		lastErr = fmt.Errorf("uptime of %ds is not lower than before the reboot", uptime)
+	}
+}
+
+// isConnectionDropped reports whether the box closed the connection before answering, which it often does
+// when it goes down right away after being asked to reboot.
+func isConnectionDropped(err error) bool {
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, syscall.ECONNRESET)
+}
+
+// getUptimeAfterReboot returns the uptime of the box, logging in first when it was seen going down. Otherwise the
+// current session is used, and renewed if the box rejects it.
+func (c *client) getUptimeAfterReboot(ctx context.Context, login bool) (int64, error) {
+	if login {
+		if _, err := c.Login(ctx); err != nil {
+			return 0, fmt.Errorf("failed to login: %w", err)
+		}
+	}
+
+	info, err := c.GetSystemInfo(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get system info: %w", err)
+	}
+
+	return info.UptimeVal, nil
+}
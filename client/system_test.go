@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -116,4 +117,215 @@ var _ = Describe("system", func() {
 			})
 		})
 	})
+
+	Context("rebooting the freebox", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.Reboot(context.Background())
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/system/reboot/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("shutting down the freebox", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.Shutdown(context.Background())
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/system/shutdown/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("rebooting the freebox and waiting for it", func() {
+		var timeout time.Duration
+		returnedUptime := new(int64)
+		apiVersionHandler := func(status int) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/api_version", version)),
+				ghttp.RespondWith(status, `{"api_version": "0", "device_name": "Freebox Server"}`),
+			)
+		}
+		systemHandler := func(uptime int64) http.HandlerFunc {
+			return ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/system/", version)),
+				verifyAuth(*sessionToken),
+				ghttp.RespondWith(http.StatusOK, fmt.Sprintf(`{"success": true, "result": {"uptime_val": %d}}`, uptime)),
+			)
+		}
+		BeforeEach(func() {
+			timeout = time.Minute
+
+			previous := client.RebootPollInterval
+			DeferCleanup(func() {
+				client.RebootPollInterval = previous
+			})
+			client.RebootPollInterval = time.Millisecond
+
+			server.AppendHandlers(
+				systemHandler(86400),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/system/reboot/", version)),
+					verifyAuth(*sessionToken),
+					ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+				),
+			)
+		})
+		JustBeforeEach(func() {
+			*returnedUptime, *returnedErr = freeboxClient.RebootAndWait(context.Background(), timeout)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					apiVersionHandler(http.StatusServiceUnavailable),
+					apiVersionHandler(http.StatusOK),
+				)
+				setupLoginFlow(server)
+				server.AppendHandlers(systemHandler(0))
+			})
+			It("should log in again and return the new uptime", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedUptime).To(Equal(int64(0)))
+				Expect(server.ReceivedRequests()).To(HaveLen(9))
+			})
+		})
+		Context("when the freebox reboots between two polls", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					apiVersionHandler(http.StatusOK),
+					systemHandler(0),
+				)
+			})
+			It("should return the new uptime without logging in again", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedUptime).To(Equal(int64(0)))
+				Expect(server.ReceivedRequests()).To(HaveLen(6))
+			})
+		})
+		Context("when the freebox answers before going down", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					apiVersionHandler(http.StatusOK),
+					systemHandler(86401),
+					apiVersionHandler(http.StatusServiceUnavailable),
+					apiVersionHandler(http.StatusOK),
+				)
+				setupLoginFlow(server)
+				server.AppendHandlers(systemHandler(0))
+			})
+			It("should keep waiting and only log in once it went down and came back", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedUptime).To(Equal(int64(0)))
+				Expect(server.ReceivedRequests()).To(HaveLen(11))
+			})
+		})
+		Context("when the uptime has not been reset", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					apiVersionHandler(http.StatusServiceUnavailable),
+					apiVersionHandler(http.StatusOK),
+				)
+				setupLoginFlow(server)
+				server.AppendHandlers(
+					systemHandler(86400),
+					apiVersionHandler(http.StatusOK),
+				)
+				setupLoginFlow(server)
+				server.AppendHandlers(systemHandler(0))
+			})
+			It("should keep waiting until it has", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedUptime).To(Equal(int64(0)))
+				Expect(server.ReceivedRequests()).To(HaveLen(13))
+			})
+		})
+		Context("when the freebox drops the connection of the reboot request", func() {
+			BeforeEach(func() {
+				server.SetHandler(3, ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/system/reboot/", version)),
+					func(w http.ResponseWriter, _ *http.Request) {
+						conn, _, err := w.(http.Hijacker).Hijack()
+						Expect(err).To(BeNil())
+						Expect(conn.Close()).To(Succeed())
+					},
+				))
+				server.AppendHandlers(
+					apiVersionHandler(http.StatusServiceUnavailable),
+					apiVersionHandler(http.StatusOK),
+				)
+				setupLoginFlow(server)
+				server.AppendHandlers(systemHandler(0))
+			})
+			It("should consider the reboot as initiated", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedUptime).To(Equal(int64(0)))
+				Expect(server.ReceivedRequests()).To(HaveLen(9))
+			})
+		})
+		Context("when the timeout is not positive", func() {
+			BeforeEach(func() {
+				timeout = 0
+			})
+			It("should return an error without rebooting the freebox", func() {
+				Expect(*returnedErr).To(MatchError(ContainSubstring("timeout must be positive")))
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+			})
+		})
+		Context("when the freebox does not come back before the timeout", func() {
+			BeforeEach(func() {
+				timeout = 50 * time.Millisecond
+				server.AllowUnhandledRequests = true
+				server.UnhandledRequestStatusCode = http.StatusServiceUnavailable
+			})
+			It("should return ErrRebootTimeout", func() {
+				Expect(*returnedErr).To(MatchError(client.ErrRebootTimeout))
+			})
+		})
+		Context("when the reboot request fails", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+				Expect(*returnedErr).ToNot(MatchError(client.ErrRebootTimeout))
+			})
+		})
+	})
 })
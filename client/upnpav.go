@@ -0,0 +1,36 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+func (c *client) GetUPnPAVConfiguration(ctx context.Context) (result types.UPnPAVConfiguration, err error) {
+	response, err := c.get(ctx, "upnpav/config/", c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to GET upnpav/config/ endpoint: %w", err)
+	}
+
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return types.UPnPAVConfiguration{}, fmt.Errorf("failed to get UPnP AV configuration from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+func (c *client) UpdateUPnPAVConfiguration(ctx context.Context, payload types.UPnPAVConfiguration) (result types.UPnPAVConfiguration, err error) {
+	response, err := c.put(ctx, "upnpav/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return result, fmt.Errorf("failed to PUT upnpav/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &result); err != nil {
+		return result, fmt.Errorf("failed to update UPnP AV configuration from generic response: %w", err)
+	}
+
+	return result, nil
+}
@@ -1,10 +1,12 @@
 package client
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -30,14 +32,115 @@ func (c *client) webSocket(ctx context.Context, endpoint string) (*websocket.Con
 
 	url.Path = url.Path + endpoint
 
-	ws, dialResponse, err := websocket.DefaultDialer.DialContext(ctx, url.String(), header)
+	handshake, err := http.NewRequestWithContext(ctx, http.MethodGet, url.String(), nil)
 	if err != nil {
+		return nil, fmt.Errorf("failed to forge websocket handshake: %w", err)
+	}
+
+	handshake.Header = header
+
+	var ws *websocket.Conn
+
+	err = c.invoke(&Call{Operation: operationName(handshake), Request: handshake, WebSocket: true}, func(call *Call) (err error) {
+		ws, err = c.dialWithRenewal(call)
+
+		return err
+	})
+
+	return ws, err
+}
+
+func (c *client) dialWithRenewal(call *Call) (*websocket.Conn, error) {
+	ctx, url, header := call.Request.Context(), call.Request.URL.String(), call.Request.Header
+
+	ws, dialResponse, err := c.dial(ctx, url, header)
+	if err != nil && isSessionRejected(dialResponse) {
+		// Same as for regular requests, the box may have invalidated the session before it expired locally
+		renewed, renewErr := c.renewSession(ctx, header.Get(AuthHeader))
+		if renewErr != nil {
+			return nil, errors.Join(err, fmt.Errorf("failed to login again after session was invalidated: %w", renewErr))
+		}
+
+		header.Set(AuthHeader, renewed.token)
+
+		ws, dialResponse, err = c.dial(ctx, url, header)
+	}
+
+	call.Response = dialResponse
+
+	if err != nil {
+		if dialResponse == nil {
+			return nil, fmt.Errorf("failed to dial websocket: %w", err)
+		}
+
 		return nil, fmt.Errorf("dialing websocket returned a status %s: %w", dialResponse.Status, err)
 	}
 
 	return ws, nil
 }
 
+// dial opens the websocket, sharing the request slots and the rate limit with regular requests.
+func (c *client) dial(ctx context.Context, url string, header http.Header) (ws *websocket.Conn, dialResponse *http.Response, err error) {
+	release, err := c.acquire(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer release()
+
+	defer func() { c.observeRateLimit(err) }()
+
+	ws, dialResponse, err = websocket.DefaultDialer.DialContext(ctx, url, header)
+	if err != nil {
+		if apiError := handshakeAPIError(dialResponse); apiError != nil {
+			return nil, dialResponse, fmt.Errorf("%w: %w", err, apiError)
+		}
+
+		return nil, dialResponse, err //nolint:wrapcheck
+	}
+
+	return ws, dialResponse, nil
+}
+
+// handshakeAPIError returns the error reported in the body of a failed websocket handshake, if any.
+// The body is left readable for the caller.
+func handshakeAPIError(dialResponse *http.Response) *APIError {
+	if dialResponse == nil || dialResponse.Body == nil {
+		return nil
+	}
+
+	body, err := io.ReadAll(dialResponse.Body)
+	dialResponse.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err != nil {
+		return nil
+	}
+
+	response := new(genericResponse)
+	if err := json.Unmarshal(body, response); err != nil || response.ErrorCode == "" {
+		return nil
+	}
+
+	return &APIError{Code: response.ErrorCode, Message: response.Message}
+}
+
+// isSessionRejected reports whether a failed websocket handshake was refused because of the session.
+func isSessionRejected(dialResponse *http.Response) bool {
+	if dialResponse == nil {
+		return false
+	}
+
+	if dialResponse.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+
+	response := new(genericResponse)
+	if err := json.NewDecoder(dialResponse.Body).Decode(response); err != nil {
+		return false
+	}
+
+	return isSessionError(&APIError{Code: response.ErrorCode})
+}
+
 func waitWebSocketResponse[R interface{}](ctx context.Context, ws *websocket.Conn, requestID types.WebSocketRequestID, action types.WebSocketAction) (*R, error) {
 	for {
 		message, err := waitJSONResponse[types.WebSocketResponse[R]](ctx, ws)
@@ -0,0 +1,378 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+const (
+	codeWifiNotFound = "noent"
+)
+
+// GetWifiGlobalConfig returns the global Wi-Fi configuration.
+func (c *client) GetWifiGlobalConfig(ctx context.Context) (config types.WifiGlobalConfig, err error) {
+	response, err := c.get(ctx, "wifi/config/", c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to GET wifi/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get wifi global config from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// UpdateWifiGlobalConfig updates the global Wi-Fi configuration.
+func (c *client) UpdateWifiGlobalConfig(ctx context.Context, payload types.WifiGlobalConfig) (config types.WifiGlobalConfig, err error) {
+	response, err := c.put(ctx, "wifi/config/", payload, c.withSession(ctx))
+	if err != nil {
+		return config, fmt.Errorf("failed to PUT wifi/config/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &config); err != nil {
+		return config, fmt.Errorf("failed to get updated wifi global config from generic response: %w", err)
+	}
+
+	return config, nil
+}
+
+// ResetWifiConfig resets the Wi-Fi configuration to its factory defaults.
+func (c *client) ResetWifiConfig(ctx context.Context) error {
+	if _, err := c.post(ctx, "wifi/config/reset/", nil, c.withSession(ctx)); err != nil {
+		return fmt.Errorf("failed to POST wifi/config/reset/ endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// ListWifiAccessPoints returns every Wi-Fi access point.
+func (c *client) ListWifiAccessPoints(ctx context.Context) ([]types.WifiAccessPoint, error) {
+	response, err := c.get(ctx, "wifi/ap/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET wifi/ap/ endpoint: %w", err)
+	}
+
+	result := make([]types.WifiAccessPoint, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi access points from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetWifiAccessPoint returns the Wi-Fi access point with the given identifier.
+func (c *client) GetWifiAccessPoint(ctx context.Context, identifier int64) (accessPoint types.WifiAccessPoint, err error) {
+	response, err := c.get(ctx, fmt.Sprintf("wifi/ap/%d", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return accessPoint, ErrWifiAccessPointNotFound
+		}
+
+		return accessPoint, fmt.Errorf("failed to GET wifi/ap/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &accessPoint); err != nil {
+		return accessPoint, fmt.Errorf("failed to get wifi access point from generic response: %w", err)
+	}
+
+	return accessPoint, nil
+}
+
+type updateWifiAccessPointPayload struct {
+	Config types.WifiAccessPointConfig `json:"config"`
+}
+
+// UpdateWifiAccessPoint updates the configuration of the given Wi-Fi access point.
+func (c *client) UpdateWifiAccessPoint(
+	ctx context.Context,
+	identifier int64,
+	config types.WifiAccessPointConfig,
+) (accessPoint types.WifiAccessPoint, err error) {
+	response, err := c.put(ctx, fmt.Sprintf("wifi/ap/%d", identifier), updateWifiAccessPointPayload{Config: config}, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return accessPoint, ErrWifiAccessPointNotFound
+		}
+
+		return accessPoint, fmt.Errorf("failed to PUT wifi/ap/%d endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &accessPoint); err != nil {
+		return accessPoint, fmt.Errorf("failed to get updated wifi access point from generic response: %w", err)
+	}
+
+	return accessPoint, nil
+}
+
+// ListWifiAllowedChannelCombinations returns the channel combinations allowed on the given access point.
+func (c *client) ListWifiAllowedChannelCombinations(ctx context.Context, identifier int64) ([]types.WifiAllowedChannelCombination, error) {
+	response, err := c.get(ctx, fmt.Sprintf("wifi/ap/%d/allowed_channel_comb/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return nil, ErrWifiAccessPointNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET wifi/ap/%d/allowed_channel_comb/ endpoint: %w", identifier, err)
+	}
+
+	result := make([]types.WifiAllowedChannelCombination, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi allowed channel combinations from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// ListWifiStations returns the stations associated with the given access point.
+func (c *client) ListWifiStations(ctx context.Context, identifier int64) ([]types.WifiStation, error) {
+	response, err := c.get(ctx, fmt.Sprintf("wifi/ap/%d/stations/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return nil, ErrWifiAccessPointNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET wifi/ap/%d/stations/ endpoint: %w", identifier, err)
+	}
+
+	result := make([]types.WifiStation, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi stations from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// ListWifiNeighbors returns the networks detected by the given access point.
+func (c *client) ListWifiNeighbors(ctx context.Context, identifier int64) ([]types.WifiNeighbor, error) {
+	response, err := c.get(ctx, fmt.Sprintf("wifi/ap/%d/neighbors/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return nil, ErrWifiAccessPointNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET wifi/ap/%d/neighbors/ endpoint: %w", identifier, err)
+	}
+
+	result := make([]types.WifiNeighbor, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi neighbors from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// ListWifiChannelUsage returns the usage of each channel as seen by the given access point.
+func (c *client) ListWifiChannelUsage(ctx context.Context, identifier int64) ([]types.WifiChannelUsage, error) {
+	response, err := c.get(ctx, fmt.Sprintf("wifi/ap/%d/channel_usage/", identifier), c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return nil, ErrWifiAccessPointNotFound
+		}
+
+		return nil, fmt.Errorf("failed to GET wifi/ap/%d/channel_usage/ endpoint: %w", identifier, err)
+	}
+
+	result := make([]types.WifiChannelUsage, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi channel usage from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// RefreshWifiRadar triggers a new neighbors and channel usage scan on the given access point.
+func (c *client) RefreshWifiRadar(ctx context.Context, identifier int64) error {
+	response, err := c.post(ctx, fmt.Sprintf("wifi/ap/%d/neighbors/scan/", identifier), nil, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return ErrWifiAccessPointNotFound
+		}
+
+		return fmt.Errorf("failed to POST wifi/ap/%d/neighbors/scan/ endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
+
+// ListWifiBSS returns every Basic Service Set.
+func (c *client) ListWifiBSS(ctx context.Context) ([]types.WifiBSS, error) {
+	response, err := c.get(ctx, "wifi/bss/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET wifi/bss/ endpoint: %w", err)
+	}
+
+	result := make([]types.WifiBSS, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi bss from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetWifiBSS returns the Basic Service Set with the given BSSID.
+func (c *client) GetWifiBSS(ctx context.Context, identifier string) (bss types.WifiBSS, err error) {
+	response, err := c.get(ctx, "wifi/bss/"+identifier, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return bss, ErrWifiBSSNotFound
+		}
+
+		return bss, fmt.Errorf("failed to GET wifi/bss/%s endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &bss); err != nil {
+		return bss, fmt.Errorf("failed to get wifi bss from generic response: %w", err)
+	}
+
+	return bss, nil
+}
+
+type updateWifiBSSPayload struct {
+	Config types.WifiBSSConfig `json:"config"`
+}
+
+// UpdateWifiBSS updates the configuration of the given Basic Service Set.
+func (c *client) UpdateWifiBSS(ctx context.Context, identifier string, config types.WifiBSSConfig) (bss types.WifiBSS, err error) {
+	response, err := c.put(ctx, "wifi/bss/"+identifier, updateWifiBSSPayload{Config: config}, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return bss, ErrWifiBSSNotFound
+		}
+
+		return bss, fmt.Errorf("failed to PUT wifi/bss/%s endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &bss); err != nil {
+		return bss, fmt.Errorf("failed to get updated wifi bss from generic response: %w", err)
+	}
+
+	return bss, nil
+}
+
+// GetWifiPlanning returns the weekly Wi-Fi planning.
+func (c *client) GetWifiPlanning(ctx context.Context) (planning types.WifiPlanning, err error) {
+	response, err := c.get(ctx, "wifi/planning/", c.withSession(ctx))
+	if err != nil {
+		return planning, fmt.Errorf("failed to GET wifi/planning/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &planning); err != nil {
+		return planning, fmt.Errorf("failed to get wifi planning from generic response: %w", err)
+	}
+
+	return planning, nil
+}
+
+// UpdateWifiPlanning updates the weekly Wi-Fi planning.
+func (c *client) UpdateWifiPlanning(ctx context.Context, payload types.WifiPlanning) (planning types.WifiPlanning, err error) {
+	response, err := c.put(ctx, "wifi/planning/", payload, c.withSession(ctx))
+	if err != nil {
+		return planning, fmt.Errorf("failed to PUT wifi/planning/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &planning); err != nil {
+		return planning, fmt.Errorf("failed to get updated wifi planning from generic response: %w", err)
+	}
+
+	return planning, nil
+}
+
+// ListWifiMacFilters returns every MAC filter entry.
+func (c *client) ListWifiMacFilters(ctx context.Context) ([]types.WifiMacFilter, error) {
+	response, err := c.get(ctx, "wifi/mac_filter/", c.withSession(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("failed to GET wifi/mac_filter/ endpoint: %w", err)
+	}
+
+	result := make([]types.WifiMacFilter, 0)
+	if response.Result != nil {
+		if err = c.fromGenericResponse(response, &result); err != nil {
+			return nil, fmt.Errorf("failed to get wifi mac filters from generic response: %w", err)
+		}
+	}
+
+	return result, nil
+}
+
+// GetWifiMacFilter returns the MAC filter entry with the given identifier.
+func (c *client) GetWifiMacFilter(ctx context.Context, identifier string) (filter types.WifiMacFilter, err error) {
+	response, err := c.get(ctx, "wifi/mac_filter/"+identifier, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return filter, ErrWifiMacFilterNotFound
+		}
+
+		return filter, fmt.Errorf("failed to GET wifi/mac_filter/%s endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &filter); err != nil {
+		return filter, fmt.Errorf("failed to get wifi mac filter from generic response: %w", err)
+	}
+
+	return filter, nil
+}
+
+// CreateWifiMacFilter creates a new MAC filter entry.
+func (c *client) CreateWifiMacFilter(ctx context.Context, payload types.WifiMacFilterPayload) (filter types.WifiMacFilter, err error) {
+	response, err := c.post(ctx, "wifi/mac_filter/", payload, c.withSession(ctx))
+	if err != nil {
+		return filter, fmt.Errorf("failed to POST wifi/mac_filter/ endpoint: %w", err)
+	}
+
+	if err = c.fromGenericResponse(response, &filter); err != nil {
+		return filter, fmt.Errorf("failed to get created wifi mac filter from generic response: %w", err)
+	}
+
+	return filter, nil
+}
+
+// UpdateWifiMacFilter updates the MAC filter entry with the given identifier.
+func (c *client) UpdateWifiMacFilter(
+	ctx context.Context,
+	identifier string,
+	payload types.WifiMacFilterPayload,
+) (filter types.WifiMacFilter, err error) {
+	response, err := c.put(ctx, "wifi/mac_filter/"+identifier, payload, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return filter, ErrWifiMacFilterNotFound
+		}
+
+		return filter, fmt.Errorf("failed to PUT wifi/mac_filter/%s endpoint: %w", identifier, err)
+	}
+
+	if err = c.fromGenericResponse(response, &filter); err != nil {
+		return filter, fmt.Errorf("failed to get updated wifi mac filter from generic response: %w", err)
+	}
+
+	return filter, nil
+}
+
+// DeleteWifiMacFilter deletes the MAC filter entry with the given identifier.
+func (c *client) DeleteWifiMacFilter(ctx context.Context, identifier string) error {
+	response, err := c.delete(ctx, "wifi/mac_filter/"+identifier, c.withSession(ctx))
+	if err != nil {
+		if response != nil && response.ErrorCode == codeWifiNotFound {
+			return ErrWifiMacFilterNotFound
+		}
+
+		return fmt.Errorf("failed to DELETE wifi/mac_filter/%s endpoint: %w", identifier, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1252 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	. "github.com/onsi/gomega/gstruct"
+
+	"github.com/nikolalohinski/free-go/client"
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("wifi", func() {
+	var (
+		freeboxClient client.Client
+
+		ctx context.Context
+
+		server   *ghttp.Server
+		endpoint = new(string)
+
+		sessionToken = new(string)
+
+		returnedErr = new(error)
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		server = ghttp.NewServer()
+		DeferCleanup(server.Close)
+
+		*endpoint = server.Addr()
+
+		freeboxClient = Must(client.New(*endpoint, version)).
+			WithAppID(appID).
+			WithPrivateToken(privateToken)
+
+		*sessionToken = setupLoginFlow(server)
+	})
+
+	// ── Global configuration ────────────────────────────────────────────────────
+
+	Context("getting the global configuration", func() {
+		returnedConfig := new(types.WifiGlobalConfig)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.GetWifiGlobalConfig(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/config/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"enabled": true,
+								"mac_filter_state": "blacklist"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedConfig).To(Equal(types.WifiGlobalConfig{
+					Enabled:        true,
+					MacFilterState: types.WifiMacFilterStateBlacklist,
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+		Context("when the context is nil", func() {
+			BeforeEach(func() {
+				ctx = nil
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the global configuration", func() {
+		returnedConfig := new(types.WifiGlobalConfig)
+		JustBeforeEach(func() {
+			*returnedConfig, *returnedErr = freeboxClient.UpdateWifiGlobalConfig(ctx, types.WifiGlobalConfig{
+				Enabled: false,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/config/", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"enabled": false}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"enabled": false,
+								"mac_filter_state": "disabled"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated configuration", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedConfig.Enabled).To(BeFalse())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("resetting the configuration", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.ResetWifiConfig(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/wifi/config/reset/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── Access points ───────────────────────────────────────────────────────────
+
+	Context("listing access points", func() {
+		returnedAccessPoints := new([]types.WifiAccessPoint)
+		JustBeforeEach(func() {
+			*returnedAccessPoints, *returnedErr = freeboxClient.ListWifiAccessPoints(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": 0,
+									"name": "2.4G",
+									"status": {
+										"state": "active",
+										"channel_width": "20",
+										"primary_channel": 6,
+										"secondary_channel": 0,
+										"dfs_cac_remaining_time": 0,
+										"dfs_disabled": false
+									},
+									"capabilities": {
+										"2d4g": {
+											"ht_20": true,
+											"ht_40": true
+										}
+									},
+									"config": {
+										"band": "2d4g",
+										"channel_width": "20",
+										"primary_channel": 0,
+										"secondary_channel": 0,
+										"dfs_enabled": false,
+										"ht": {
+											"ht_enabled": true,
+											"ac_enabled": false
+										}
+									}
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct access points", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedAccessPoints).To(HaveLen(1))
+				Expect((*returnedAccessPoints)[0]).To(MatchFields(IgnoreExtras, Fields{
+					"ID":   Equal(int64(0)),
+					"Name": Equal("2.4G"),
+					"Status": MatchFields(IgnoreExtras, Fields{
+						"State":          Equal(types.WifiAccessPointStateActive),
+						"PrimaryChannel": Equal(int64(6)),
+					}),
+					"Capabilities": HaveKeyWithValue(types.WifiBand2G4, HaveKeyWithValue("ht_40", true)),
+					"Config": MatchFields(IgnoreExtras, Fields{
+						"Band": Equal(types.WifiBand2G4),
+						"HT": PointTo(MatchFields(IgnoreExtras, Fields{
+							"HTEnabled": Equal(true),
+						})),
+					}),
+				}))
+			})
+		})
+		Context("when there are no access points", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedAccessPoints).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting an access point", func() {
+		const identifier = int64(1)
+		returnedAccessPoint := new(types.WifiAccessPoint)
+		JustBeforeEach(func() {
+			*returnedAccessPoint, *returnedErr = freeboxClient.GetWifiAccessPoint(ctx, identifier)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 1,
+								"name": "5G",
+								"status": {
+									"state": "dfs",
+									"dfs_cac_remaining_time": 42
+								},
+								"config": {
+									"band": "5g",
+									"channel_width": "80",
+									"dfs_enabled": true
+								}
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct access point", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedAccessPoint).To(MatchFields(IgnoreExtras, Fields{
+					"ID": Equal(identifier),
+					"Status": MatchFields(IgnoreExtras, Fields{
+						"State":               Equal(types.WifiAccessPointStateDFS),
+						"DFSCACRemainingTime": Equal(int64(42)),
+					}),
+					"Config": MatchFields(IgnoreExtras, Fields{
+						"Band":         Equal(types.WifiBand5G),
+						"ChannelWidth": Equal("80"),
+						"DFSEnabled":   Equal(true),
+					}),
+				}))
+			})
+		})
+		Context("when the access point is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiAccessPointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiAccessPointNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating an access point", func() {
+		const identifier = int64(1)
+		returnedAccessPoint := new(types.WifiAccessPoint)
+		JustBeforeEach(func() {
+			*returnedAccessPoint, *returnedErr = freeboxClient.UpdateWifiAccessPoint(ctx, identifier, types.WifiAccessPointConfig{
+				Band:           types.WifiBand5G,
+				ChannelWidth:   "80",
+				PrimaryChannel: 36,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/ap/1", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"config": {
+								"band": "5g",
+								"channel_width": "80",
+								"primary_channel": 36,
+								"secondary_channel": 0,
+								"dfs_enabled": false
+							}
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": 1,
+								"config": {
+									"band": "5g",
+									"channel_width": "80",
+									"primary_channel": 36
+								}
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated access point", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedAccessPoint.Config.PrimaryChannel).To(Equal(int64(36)))
+			})
+		})
+		Context("when the access point is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/ap/1", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiAccessPointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiAccessPointNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the allowed channel combinations of an access point", func() {
+		returnedCombinations := new([]types.WifiAllowedChannelCombination)
+		JustBeforeEach(func() {
+			*returnedCombinations, *returnedErr = freeboxClient.ListWifiAllowedChannelCombinations(ctx, 1)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/1/allowed_channel_comb/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"band": "5g",
+									"channel_width": "80",
+									"need_dfs": false,
+									"primary": [36, 40, 44, 48],
+									"secondary": [0]
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct combinations", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedCombinations).To(ConsistOf(types.WifiAllowedChannelCombination{
+					Band:         types.WifiBand5G,
+					ChannelWidth: "80",
+					Primary:      []int64{36, 40, 44, 48},
+					Secondary:    []int64{0},
+				}))
+			})
+		})
+		Context("when the access point is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/1/allowed_channel_comb/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiAccessPointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiAccessPointNotFound))
+			})
+		})
+	})
+
+	Context("listing the stations of an access point", func() {
+		returnedStations := new([]types.WifiStation)
+		JustBeforeEach(func() {
+			*returnedStations, *returnedErr = freeboxClient.ListWifiStations(ctx, 0)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/0/stations/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": "AA:BB:CC:DD:EE:FF-00:24:D4:00:00:01",
+									"mac": "AA:BB:CC:DD:EE:FF",
+									"bssid": "00:24:D4:00:00:01",
+									"hostname": "laptop",
+									"host": {
+										"id": "ether-aa:bb:cc:dd:ee:ff",
+										"primary_name": "laptop"
+									},
+									"state": "authenticated",
+									"inactive": 2,
+									"conn_duration": 3600,
+									"rx_bytes": 1000,
+									"tx_bytes": 2000,
+									"signal": -55,
+									"flags": {
+										"legacy": false,
+										"ht": true,
+										"vht": false,
+										"authorized": true
+									},
+									"last_rx": {
+										"bitrate": 1300,
+										"mcs": 7,
+										"width": "40",
+										"shortgi": true
+									}
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct stations", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStations).To(HaveLen(1))
+				Expect((*returnedStations)[0]).To(MatchFields(IgnoreExtras, Fields{
+					"Mac":      Equal("AA:BB:CC:DD:EE:FF"),
+					"Hostname": Equal("laptop"),
+					"State":    Equal(types.WifiStationStateAuthenticated),
+					"Signal":   Equal(int64(-55)),
+					"Host": PointTo(MatchFields(IgnoreExtras, Fields{
+						"PrimaryName": Equal("laptop"),
+					})),
+					"Flags": Equal(types.WifiStationFlags{HT: true, Authorized: true}),
+					"LastRX": MatchFields(IgnoreExtras, Fields{
+						"Bitrate": Equal(int64(1300)),
+						"ShortGI": Equal(true),
+					}),
+				}))
+			})
+		})
+		Context("when there are no stations", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/0/stations/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedStations).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the neighbors of an access point", func() {
+		returnedNeighbors := new([]types.WifiNeighbor)
+		JustBeforeEach(func() {
+			*returnedNeighbors, *returnedErr = freeboxClient.ListWifiNeighbors(ctx, 0)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/0/neighbors/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"bssid": "11:22:33:44:55:66",
+									"ssid": "neighbor",
+									"band": "2d4g",
+									"channel_width": "20",
+									"channel": 11,
+									"signal": -80,
+									"capabilities": {
+										"legacy": true,
+										"ht": true
+									}
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct neighbors", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedNeighbors).To(HaveLen(1))
+				Expect((*returnedNeighbors)[0]).To(MatchFields(IgnoreExtras, Fields{
+					"SSID":         Equal("neighbor"),
+					"Channel":      Equal(int64(11)),
+					"Signal":       Equal(int64(-80)),
+					"Capabilities": Equal(types.WifiNeighborCapabilities{Legacy: true, HT: true}),
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("listing the channel usage of an access point", func() {
+		returnedUsage := new([]types.WifiChannelUsage)
+		JustBeforeEach(func() {
+			*returnedUsage, *returnedErr = freeboxClient.ListWifiChannelUsage(ctx, 0)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/0/channel_usage/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"band": "2d4g",
+									"channel": 6,
+									"noise_level": -92,
+									"rx_busy_percent": 10,
+									"tx_percent": 5,
+									"busy_percent": 15
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct channel usage", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedUsage).To(ConsistOf(types.WifiChannelUsage{
+					Band:          types.WifiBand2G4,
+					Channel:       6,
+					NoiseLevel:    -92,
+					RXBusyPercent: 10,
+					TXPercent:     5,
+					BusyPercent:   15,
+				}))
+			})
+		})
+		Context("when the access point is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/ap/0/channel_usage/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiAccessPointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiAccessPointNotFound))
+			})
+		})
+	})
+
+	Context("refreshing the radar of an access point", func() {
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.RefreshWifiRadar(ctx, 0)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/wifi/ap/0/neighbors/scan/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the access point is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/wifi/ap/0/neighbors/scan/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiAccessPointNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiAccessPointNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── Basic Service Sets ──────────────────────────────────────────────────────
+
+	Context("listing basic service sets", func() {
+		returnedBSS := new([]types.WifiBSS)
+		JustBeforeEach(func() {
+			*returnedBSS, *returnedErr = freeboxClient.ListWifiBSS(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/bss/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": "00:24:D4:00:00:01",
+									"phy_id": 0,
+									"status": {
+										"state": "active",
+										"sta_count": 3,
+										"authorized_sta_count": 3,
+										"is_main_bss": true
+									},
+									"config": {
+										"enabled": true,
+										"use_default_config": true,
+										"ssid": "Freebox-000001",
+										"hide_ssid": false,
+										"encryption": "wpa2_psk_ccmp",
+										"key": "secret",
+										"eapol_version": 2
+									}
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct basic service sets", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedBSS).To(ConsistOf(types.WifiBSS{
+					ID:    "00:24:D4:00:00:01",
+					PhyID: 0,
+					Status: types.WifiBSSStatus{
+						State:              types.WifiBSSStateActive,
+						StaCount:           3,
+						AuthorizedStaCount: 3,
+						IsMainBSS:          true,
+					},
+					Config: types.WifiBSSConfig{
+						Enabled:          true,
+						UseDefaultConfig: true,
+						SSID:             "Freebox-000001",
+						Encryption:       types.WifiEncryptionWPA2PSKCCMP,
+						Key:              "secret",
+						EAPOLVersion:     2,
+					},
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a basic service set", func() {
+		const identifier = "00:24:D4:00:00:01"
+		returnedBSS := new(types.WifiBSS)
+		JustBeforeEach(func() {
+			*returnedBSS, *returnedErr = freeboxClient.GetWifiBSS(ctx, identifier)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/bss/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": "00:24:D4:00:00:01",
+								"phy_id": 0,
+								"config": {
+									"enabled": true,
+									"ssid": "Freebox-000001"
+								}
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct basic service set", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedBSS.ID).To(Equal(identifier))
+				Expect(returnedBSS.Config.SSID).To(Equal("Freebox-000001"))
+			})
+		})
+		Context("when the basic service set is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/bss/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiBSSNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiBSSNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a basic service set", func() {
+		const identifier = "00:24:D4:00:00:01"
+		returnedBSS := new(types.WifiBSS)
+		JustBeforeEach(func() {
+			*returnedBSS, *returnedErr = freeboxClient.UpdateWifiBSS(ctx, identifier, types.WifiBSSConfig{
+				Enabled:    true,
+				SSID:       "rotated",
+				Encryption: types.WifiEncryptionWPA23PSKCCMP,
+				Key:        "new-secret",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/bss/%s", version, identifier)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"config": {
+								"enabled": true,
+								"use_default_config": false,
+								"ssid": "rotated",
+								"hide_ssid": false,
+								"encryption": "wpa23_psk_ccmp",
+								"key": "new-secret"
+							}
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": "00:24:D4:00:00:01",
+								"config": {
+									"enabled": true,
+									"ssid": "rotated",
+									"encryption": "wpa23_psk_ccmp"
+								}
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated basic service set", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedBSS.Config).To(MatchFields(IgnoreExtras, Fields{
+					"SSID":       Equal("rotated"),
+					"Encryption": Equal(types.WifiEncryptionWPA23PSKCCMP),
+				}))
+			})
+		})
+		Context("when the basic service set is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/bss/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiBSSNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiBSSNotFound))
+			})
+		})
+	})
+
+	// ── Planning ────────────────────────────────────────────────────────────────
+
+	Context("getting the planning", func() {
+		returnedPlanning := new(types.WifiPlanning)
+		JustBeforeEach(func() {
+			*returnedPlanning, *returnedErr = freeboxClient.GetWifiPlanning(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/planning/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"use_planning": true,
+								"resolution": 2,
+								"mapping": ["on", "off"]
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct planning", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedPlanning).To(Equal(types.WifiPlanning{
+					UsePlanning: true,
+					Resolution:  2,
+					Mapping:     []types.WifiPlanningMode{types.WifiPlanningModeOn, types.WifiPlanningModeOff},
+				}))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating the planning", func() {
+		returnedPlanning := new(types.WifiPlanning)
+		JustBeforeEach(func() {
+			*returnedPlanning, *returnedErr = freeboxClient.UpdateWifiPlanning(ctx, types.WifiPlanning{
+				UsePlanning: false,
+				Mapping:     []types.WifiPlanningMode{types.WifiPlanningModeOn, types.WifiPlanningModeOn},
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/planning/", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"use_planning": false,
+							"mapping": ["on", "on"]
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"use_planning": false,
+								"resolution": 2,
+								"mapping": ["on", "on"]
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated planning", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedPlanning.UsePlanning).To(BeFalse())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	// ── MAC filter ──────────────────────────────────────────────────────────────
+
+	Context("listing MAC filter entries", func() {
+		returnedFilters := new([]types.WifiMacFilter)
+		JustBeforeEach(func() {
+			*returnedFilters, *returnedErr = freeboxClient.ListWifiMacFilters(ctx)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/mac_filter/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": [
+								{
+									"id": "AA:BB:CC:DD:EE:FF-blacklist",
+									"mac": "AA:BB:CC:DD:EE:FF",
+									"comment": "intruder",
+									"type": "blacklist",
+									"hostname": "unknown"
+								}
+							]
+						}`),
+					),
+				)
+			})
+			It("should return the correct entries", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedFilters).To(ConsistOf(types.WifiMacFilter{
+					WifiMacFilterPayload: types.WifiMacFilterPayload{
+						Mac:     "AA:BB:CC:DD:EE:FF",
+						Comment: "intruder",
+						Type:    types.WifiMacFilterTypeBlacklist,
+					},
+					ID:       "AA:BB:CC:DD:EE:FF-blacklist",
+					Hostname: "unknown",
+				}))
+			})
+		})
+		Context("when there are no entries", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/mac_filter/", version)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should return an empty slice without error", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*returnedFilters).To(BeEmpty())
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("getting a MAC filter entry", func() {
+		const identifier = "AA:BB:CC:DD:EE:FF-blacklist"
+		returnedFilter := new(types.WifiMacFilter)
+		JustBeforeEach(func() {
+			*returnedFilter, *returnedErr = freeboxClient.GetWifiMacFilter(ctx, identifier)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/mac_filter/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": "AA:BB:CC:DD:EE:FF-blacklist",
+								"mac": "AA:BB:CC:DD:EE:FF",
+								"type": "blacklist"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the correct entry", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFilter.ID).To(Equal(identifier))
+				Expect(returnedFilter.Type).To(Equal(types.WifiMacFilterTypeBlacklist))
+			})
+		})
+		Context("when the entry is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodGet, fmt.Sprintf("/api/%s/wifi/mac_filter/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiMacFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiMacFilterNotFound))
+			})
+		})
+	})
+
+	Context("creating a MAC filter entry", func() {
+		returnedFilter := new(types.WifiMacFilter)
+		JustBeforeEach(func() {
+			*returnedFilter, *returnedErr = freeboxClient.CreateWifiMacFilter(ctx, types.WifiMacFilterPayload{
+				Mac:     "AA:BB:CC:DD:EE:FF",
+				Comment: "laptop",
+				Type:    types.WifiMacFilterTypeWhitelist,
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPost, fmt.Sprintf("/api/%s/wifi/mac_filter/", version)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{
+							"mac": "AA:BB:CC:DD:EE:FF",
+							"comment": "laptop",
+							"type": "whitelist"
+						}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": "AA:BB:CC:DD:EE:FF-whitelist",
+								"mac": "AA:BB:CC:DD:EE:FF",
+								"comment": "laptop",
+								"type": "whitelist"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the created entry", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFilter.ID).To(Equal("AA:BB:CC:DD:EE:FF-whitelist"))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+
+	Context("updating a MAC filter entry", func() {
+		const identifier = "AA:BB:CC:DD:EE:FF-whitelist"
+		returnedFilter := new(types.WifiMacFilter)
+		JustBeforeEach(func() {
+			*returnedFilter, *returnedErr = freeboxClient.UpdateWifiMacFilter(ctx, identifier, types.WifiMacFilterPayload{
+				Comment: "renamed",
+			})
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/mac_filter/%s", version, identifier)),
+						ghttp.VerifyContentType("application/json"),
+						verifyAuth(*sessionToken),
+						ghttp.VerifyJSON(`{"comment": "renamed"}`),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": true,
+							"result": {
+								"id": "AA:BB:CC:DD:EE:FF-whitelist",
+								"mac": "AA:BB:CC:DD:EE:FF",
+								"comment": "renamed",
+								"type": "whitelist"
+							}
+						}`),
+					),
+				)
+			})
+			It("should return the updated entry", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(returnedFilter.Comment).To(Equal("renamed"))
+			})
+		})
+		Context("when the entry is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodPut, fmt.Sprintf("/api/%s/wifi/mac_filter/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiMacFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiMacFilterNotFound))
+			})
+		})
+	})
+
+	Context("deleting a MAC filter entry", func() {
+		const identifier = "AA:BB:CC:DD:EE:FF-whitelist"
+		JustBeforeEach(func() {
+			*returnedErr = freeboxClient.DeleteWifiMacFilter(ctx, identifier)
+		})
+		Context("default", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/wifi/mac_filter/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{"success": true}`),
+					),
+				)
+			})
+			It("should not return an error", func() {
+				Expect(*returnedErr).To(BeNil())
+			})
+		})
+		Context("when the entry is not found", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest(http.MethodDelete, fmt.Sprintf("/api/%s/wifi/mac_filter/%s", version, identifier)),
+						verifyAuth(*sessionToken),
+						ghttp.RespondWith(http.StatusOK, `{
+							"success": false,
+							"error_code": "noent"
+						}`),
+					),
+				)
+			})
+			It("should return ErrWifiMacFilterNotFound", func() {
+				Expect(*returnedErr).To(Equal(client.ErrWifiMacFilterNotFound))
+			})
+		})
+		Context("when the server fails to respond", func() {
+			BeforeEach(func() {
+				server.Close()
+			})
+			It("should return an error", func() {
+				Expect(*returnedErr).ToNot(BeNil())
+			})
+		})
+	})
+})
@@ -16,7 +16,7 @@ type Go mg.Namespace
 
 // Runs ginkgo for unit tests
 func (Go) Test(ctx context.Context) error {
-	return Run(Invoke(ctx, "Running unit tests"), "ginkgo", "-p", "--skip-package", "integration", "./...")
+	return Run(Invoke(ctx, "Running unit tests"), "ginkgo", "-p", "--race", "--skip-package", "integration", "./...")
 }
 
 // Runs ginkgo for integration test
@@ -0,0 +1,41 @@
+package types
+
+type AirMediaConfiguration struct {
+	Enabled  bool   `json:"enabled"`            // is AirMedia enabled
+	Password string `json:"password,omitempty"` // Password required to send media to the Freebox, write only
+}
+
+type AirMediaReceiverCapabilities struct {
+	Photo  bool `json:"photo"`  // The receiver can display photos
+	Screen bool `json:"screen"` // The receiver can mirror a screen
+	Audio  bool `json:"audio"`  // The receiver can play audio
+	Video  bool `json:"video"`  // The receiver can play videos
+}
+
+type AirMediaReceiver struct {
+	Name              string                       `json:"name"`               // Name of the receiver
+	PasswordProtected bool                         `json:"password_protected"` // If true, a password is required to send media to the receiver
+	Capabilities      AirMediaReceiverCapabilities `json:"capabilities"`       // Media the receiver can handle
+}
+
+type AirMediaAction = string
+
+const (
+	AirMediaActionStart AirMediaAction = "start" // Start playing the media
+	AirMediaActionStop  AirMediaAction = "stop"  // Stop playing the media
+)
+
+type AirMediaMediaType = string
+
+const (
+	AirMediaMediaTypeVideo AirMediaMediaType = "video" // Media is a video
+	AirMediaMediaTypePhoto AirMediaMediaType = "photo" // Media is a photo
+)
+
+type AirMediaReceiverRequest struct {
+	Action    AirMediaAction    `json:"action"`             // Action to perform
+	MediaType AirMediaMediaType `json:"media_type"`         // Type of the media
+	Media     string            `json:"media,omitempty"`    // URL of the media to play, required when starting
+	Position  int64             `json:"position,omitempty"` // Position in seconds to start the video from
+	Password  string            `json:"password,omitempty"` // Password of the receiver, if protected
+}
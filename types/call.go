@@ -0,0 +1,27 @@
+package types
+
+type CallType string
+
+const (
+	CallTypeMissed   CallType = "missed"   // Call was missed
+	CallTypeAccepted CallType = "accepted" // Call was accepted
+	CallTypeOutgoing CallType = "outgoing" // Call was made by the user
+)
+
+// CallEntry is an entry of the call log.
+type CallEntry struct {
+	ID        int64     `json:"id"`         // Call id
+	Type      CallType  `json:"type"`       // Call type
+	Datetime  Timestamp `json:"datetime"`   // Call creation timestamp
+	Number    string    `json:"number"`     // Calling or called number
+	Name      string    `json:"name"`       // Calling or called name
+	Duration  int64     `json:"duration"`   // Call duration in seconds
+	New       bool      `json:"new"`        // Call entry as not been acknowledged yet
+	ContactID int64     `json:"contact_id"` // If the number matches an entry in the contact database, the id of the matching contact
+	LineID    int64     `json:"line_id"`    // Id of the phone line used for the call
+}
+
+// CallEntryPayload is the payload used to update a call log entry.
+type CallEntryPayload struct {
+	New bool `json:"new"` // Set to false to acknowledge the call entry
+}
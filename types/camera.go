@@ -0,0 +1,31 @@
+package types
+
+// Camera is an IP camera paired with the Freebox.
+// Undocumented: reverse engineered from the Freebox OS web UI.
+type Camera struct {
+	ID          int64  `json:"id"`           // Camera id
+	NodeID      int64  `json:"node_id"`      // Id of the matching home node
+	Name        string `json:"name"`         // Camera name
+	Model       string `json:"model"`        // Camera model
+	Mac         string `json:"mac"`          // Camera MAC address
+	IP          string `json:"ip"`           // Camera IPv4 address on the LAN
+	Online      bool   `json:"online"`       // If true, the camera is reachable
+	StreamURL   string `json:"stream_url"`   // URL of the HLS playlist of the live stream
+	SnapshotURL string `json:"snapshot_url"` // URL of the current snapshot
+}
+
+// M3U8Segment is a media segment listed in an HLS playlist.
+type M3U8Segment struct {
+	Duration float64 // Segment duration, in seconds (#EXTINF)
+	Title    string  // Optional segment title (#EXTINF)
+	URI      string  // Segment URI, as written in the playlist
+}
+
+// M3U8Playlist is an HLS media playlist.
+type M3U8Playlist struct {
+	Version        int64         // Protocol version (#EXT-X-VERSION)
+	TargetDuration int64         // Maximum segment duration, in seconds (#EXT-X-TARGETDURATION)
+	MediaSequence  int64         // Sequence number of the first segment (#EXT-X-MEDIA-SEQUENCE)
+	EndList        bool          // If true, no segment will be added to the playlist (#EXT-X-ENDLIST)
+	Segments       []M3U8Segment // Media segments, in playback order
+}
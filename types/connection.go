@@ -0,0 +1,134 @@
+package types
+
+// ConnectionState is the state of the WAN connection.
+type ConnectionState = string
+
+const (
+	ConnectionStateGoingUp   ConnectionState = "going_up"   // Connection is initializing
+	ConnectionStateUp        ConnectionState = "up"         // Connection is active
+	ConnectionStateGoingDown ConnectionState = "going_down" // Connection is about to become inactive
+	ConnectionStateDown      ConnectionState = "down"       // Connection is inactive
+)
+
+// ConnectionType is the physical type of the WAN connection.
+type ConnectionType = string
+
+const (
+	ConnectionTypeEthernet ConnectionType = "ethernet" // FTTH ethernet
+	ConnectionTypeRFC2684  ConnectionType = "rfc2684"  // xDSL (unbundled)
+	ConnectionTypePPPoATM  ConnectionType = "pppoatm"  // xDSL
+)
+
+// ConnectionMedia is the media of the WAN connection.
+type ConnectionMedia = string
+
+const (
+	ConnectionMediaFTTH     ConnectionMedia = "ftth"      // FTTH
+	ConnectionMediaEthernet ConnectionMedia = "ethernet"  // Ethernet
+	ConnectionMediaXDSL     ConnectionMedia = "xdsl"      // xDSL (ADSL, VDSL)
+	ConnectionMediaBackup4G ConnectionMedia = "backup_4g" // Internet backup over 4G
+)
+
+// ConnectionStatus is the current status of the WAN connection.
+// https://dev.freebox.fr/sdk/os/connection/#connection-status-object
+type ConnectionStatus struct {
+	State         ConnectionState `json:"state"`           // State of the connection
+	Type          ConnectionType  `json:"type"`            // Type of the connection
+	Media         ConnectionMedia `json:"media"`           // Media of the connection
+	IPv4          string          `json:"ipv4"`            // Public IPv4 address (only available when connection is up)
+	IPv6          string          `json:"ipv6"`            // Public IPv6 address (only available when connection is up)
+	RateUp        int64           `json:"rate_up"`         // Current upload rate in byte/s
+	RateDown      int64           `json:"rate_down"`       // Current download rate in byte/s
+	BandwidthUp   int64           `json:"bandwidth_up"`    // Available upload bandwidth in bit/s
+	BandwidthDown int64           `json:"bandwidth_down"`  // Available download bandwidth in bit/s
+	BytesUp       int64           `json:"bytes_up"`        // Total uploaded bytes since last connection
+	BytesDown     int64           `json:"bytes_down"`      // Total downloaded bytes since last connection
+	IPv4PortRange []int64         `json:"ipv4_port_range"` // Range of ports usable on the public IPv4 address (first and last port)
+}
+
+// ConnectionConfiguration is the configuration of the WAN connection.
+// https://dev.freebox.fr/sdk/os/connection/#connection-configuration-object
+type ConnectionConfiguration struct {
+	Ping                bool   `json:"ping"`                             // Respond to external ping requests
+	IsSecurePass        bool   `json:"is_secure_pass,omitempty"`         // If false, the remote access password is not strong enough (read-only)
+	RemoteAccess        bool   `json:"remote_access"`                    // Allow remote access to Freebox OS
+	RemoteAccessPort    int64  `json:"remote_access_port"`               // Port used for the remote access
+	RemoteAccessMinPort int64  `json:"remote_access_min_port,omitempty"` // Lower bound for remote_access_port (read-only)
+	RemoteAccessMaxPort int64  `json:"remote_access_max_port,omitempty"` // Upper bound for remote_access_port (read-only)
+	RemoteAccessIP      string `json:"remote_access_ip,omitempty"`       // IP address to use for the remote access (read-only)
+	APIRemoteAccess     bool   `json:"api_remote_access"`                // Allow the API to be accessed remotely
+	WOL                 bool   `json:"wol"`                              // Enable Wake on LAN proxy from the WAN
+	Adblock             bool   `json:"adblock"`                          // Enable ad blocking
+	AdblockNotSet       bool   `json:"adblock_not_set,omitempty"`        // If true, the user has not yet chosen whether to enable ad blocking (read-only)
+	AllowTokenRequest   bool   `json:"allow_token_request"`              // Allow applications to request new tokens
+	SIPALG              string `json:"sip_alg,omitempty"`                // SIP ALG configuration
+}
+
+// IPv6Delegation is an IPv6 prefix delegated to a LAN router.
+type IPv6Delegation struct {
+	Prefix  string `json:"prefix"`   // Delegated IPv6 prefix (read-only)
+	NextHop string `json:"next_hop"` // Next hop for this prefix
+}
+
+// IPv6Configuration is the IPv6 configuration of the WAN connection.
+// https://dev.freebox.fr/sdk/os/connection/#connection-ipv6-configuration-object
+type IPv6Configuration struct {
+	IPv6Enabled  bool             `json:"ipv6_enabled"`          // Enable IPv6 connectivity
+	IPv6Firewall bool             `json:"ipv6_firewall"`         // Enable the IPv6 firewall
+	IPv6LLAddr   string           `json:"ipv6ll,omitempty"`      // Freebox IPv6 link-local address (read-only)
+	Delegations  []IPv6Delegation `json:"delegations,omitempty"` // List of IPv6 prefix delegations
+}
+
+// DynDNSProvider identifies one of the DynDNS services supported by the Freebox.
+type DynDNSProvider string
+
+const (
+	DynDNSProviderOVH    DynDNSProvider = "ovh"    // OVH
+	DynDNSProviderDynDNS DynDNSProvider = "dyndns" // DynDNS
+	DynDNSProviderNoIP   DynDNSProvider = "noip"   // No-IP
+)
+
+// DynDNSStatusCode is the status of a DynDNS service.
+type DynDNSStatusCode = string
+
+const (
+	DynDNSStatusOK             DynDNSStatusCode = "ok"              // Service is working
+	DynDNSStatusWait           DynDNSStatusCode = "wait"            // Service is waiting for its next update
+	DynDNSStatusDisabled       DynDNSStatusCode = "disabled"        // Service is disabled
+	DynDNSStatusErrConnection  DynDNSStatusCode = "err_connection"  // Unable to reach the provider
+	DynDNSStatusErrAuth        DynDNSStatusCode = "err_auth"        // Invalid credentials
+	DynDNSStatusErrHostname    DynDNSStatusCode = "err_hostname"    // Invalid hostname
+	DynDNSStatusErrAbuse       DynDNSStatusCode = "err_abuse"       // Account blocked for abuse
+	DynDNSStatusErrDNSInternal DynDNSStatusCode = "err_dnsinternal" // Provider internal DNS error
+	DynDNSStatusErrInternal    DynDNSStatusCode = "err_internal"    // Freebox internal error
+	DynDNSStatusErrUnknown     DynDNSStatusCode = "err_unknown"     // Unknown error
+)
+
+// DynDNSStatus is the status of a DynDNS service.
+// Endpoint: GET /connection/ddns/{provider}/status/
+type DynDNSStatus struct {
+	Status      DynDNSStatusCode `json:"status"`       // Status of the service
+	NextRefresh Timestamp        `json:"next_refresh"` // Next scheduled update
+	LastRefresh Timestamp        `json:"last_refresh"` // Last successful update
+	NextRetry   Timestamp        `json:"next_retry"`   // Next retry after a failure
+	LastError   Timestamp        `json:"last_error"`   // Last failed update
+}
+
+// DynDNSConfiguration is the configuration of a DynDNS service.
+// Endpoint: GET/PUT /connection/ddns/{provider}/
+type DynDNSConfiguration struct {
+	Enabled  bool   `json:"enabled"`            // Enable the service
+	Hostname string `json:"hostname"`           // Hostname to update
+	User     string `json:"user"`               // Account user name
+	Password string `json:"password,omitempty"` // Account password, write-only
+}
+
+// PortInRange returns whether the given port is usable on the public IPv4 address. Full stack connections,
+// which do not report any range, accept every port.
+func (s ConnectionStatus) PortInRange(port int64) bool {
+	if len(s.IPv4PortRange) < 2 {
+		return true
+	}
+
+	return port >= s.IPv4PortRange[0] && port <= s.IPv4PortRange[1]
+}
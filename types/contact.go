@@ -0,0 +1,108 @@
+package types
+
+type ContactNumberType string
+
+const (
+	ContactNumberTypeFixed  ContactNumberType = "fixed"
+	ContactNumberTypeMobile ContactNumberType = "mobile"
+	ContactNumberTypeWork   ContactNumberType = "work"
+	ContactNumberTypeFax    ContactNumberType = "fax"
+	ContactNumberTypeOther  ContactNumberType = "other"
+)
+
+type ContactAddressType string
+
+const (
+	ContactAddressTypeHome  ContactAddressType = "home"
+	ContactAddressTypeWork  ContactAddressType = "work"
+	ContactAddressTypeOther ContactAddressType = "other"
+)
+
+type ContactEmailType string
+
+const (
+	ContactEmailTypeHome  ContactEmailType = "home"
+	ContactEmailTypeWork  ContactEmailType = "work"
+	ContactEmailTypeOther ContactEmailType = "other"
+)
+
+type ContactURLType string
+
+const (
+	ContactURLTypeProfile ContactURLType = "profile"
+	ContactURLTypeBlog    ContactURLType = "blog"
+	ContactURLTypeSite    ContactURLType = "site"
+	ContactURLTypeOther   ContactURLType = "other"
+)
+
+type ContactPayload struct {
+	DisplayName string `json:"display_name"` // Contact display name
+	FirstName   string `json:"first_name"`   // Contact first name
+	LastName    string `json:"last_name"`    // Contact last name
+	Company     string `json:"company"`      // Contact company name
+	Birthday    string `json:"birthday"`     // Contact birthday
+	Notes       string `json:"notes"`        // Notes about the contact
+}
+
+// Contact is an entry of the Freebox phone book.
+type Contact struct {
+	ContactPayload
+	ID         int64            `json:"id"`          // Contact id
+	PhotoURL   string           `json:"photo_url"`   // Contact photo URL
+	LastUpdate Timestamp        `json:"last_update"` // Contact last modification time
+	Numbers    []ContactNumber  `json:"numbers"`     // Contact numbers, only filled when getting a single contact
+	Addresses  []ContactAddress `json:"addresses"`   // Contact addresses, only filled when getting a single contact
+	Emails     []ContactEmail   `json:"emails"`      // Contact emails, only filled when getting a single contact
+	URLs       []ContactURL     `json:"urls"`        // Contact URLs, only filled when getting a single contact
+}
+
+type ContactNumberPayload struct {
+	ContactID int64             `json:"contact_id"` // Id of the contact the number belongs to
+	Type      ContactNumberType `json:"type"`       // Number type
+	Number    string            `json:"number"`     // Phone number
+	IsDefault bool              `json:"is_default"` // If true, this is the default number of the contact
+}
+
+type ContactNumber struct {
+	ContactNumberPayload
+	ID    int64 `json:"id"`     // Number id
+	IsOwn bool  `json:"is_own"` // If true, this is one of the Freebox own numbers
+}
+
+type ContactAddressPayload struct {
+	ContactID int64              `json:"contact_id"` // Id of the contact the address belongs to
+	Type      ContactAddressType `json:"type"`       // Address type
+	Number    string             `json:"number"`     // Street number
+	Street    string             `json:"street"`     // Street name
+	Street2   string             `json:"street2"`    // Address complement
+	City      string             `json:"city"`       // City name
+	ZipCode   string             `json:"zipcode"`    // Postal code
+	Country   string             `json:"country"`    // Country name
+}
+
+type ContactAddress struct {
+	ContactAddressPayload
+	ID int64 `json:"id"` // Address id
+}
+
+type ContactEmailPayload struct {
+	ContactID int64            `json:"contact_id"` // Id of the contact the email belongs to
+	Type      ContactEmailType `json:"type"`       // Email type
+	Email     string           `json:"email"`      // Email address
+}
+
+type ContactEmail struct {
+	ContactEmailPayload
+	ID int64 `json:"id"` // Email id
+}
+
+type ContactURLPayload struct {
+	ContactID int64          `json:"contact_id"` // Id of the contact the URL belongs to
+	Type      ContactURLType `json:"type"`       // URL type
+	URL       string         `json:"url"`        // URL
+}
+
+type ContactURL struct {
+	ContactURLPayload
+	ID int64 `json:"id"` // URL id
+}
@@ -0,0 +1,38 @@
+package types
+
+type DHCPConfiguration struct {
+	Enabled         bool     `json:"enabled"`          // is the DHCP server enabled
+	StickyAssign    bool     `json:"sticky_assign"`    // always assign the same IP to a given host
+	Gateway         string   `json:"gateway"`          // gateway IP address (read-only)
+	Netmask         string   `json:"netmask"`          // gateway subnet netmask (read-only)
+	IPRangeStart    string   `json:"ip_range_start"`   // DHCP range start IP
+	IPRangeEnd      string   `json:"ip_range_end"`     // DHCP range end IP
+	AlwaysBroadcast bool     `json:"always_broadcast"` // always broadcast DHCP responses
+	DNS             []string `json:"dns"`              // DNS servers to include in DHCP responses
+}
+
+type DHCPv6Configuration struct {
+	Enabled      bool     `json:"enabled"`        // is the DHCPv6 server enabled
+	UseCustomDNS bool     `json:"use_custom_dns"` // use the custom DNS servers below instead of the Freebox
+	DNS          []string `json:"dns"`            // custom DNS servers to include in DHCPv6 responses
+}
+
+type DHCPDynamicLease struct {
+	Mac            string           `json:"mac"`             // Host mac address
+	Hostname       string           `json:"hostname"`        // hostname matching the mac address
+	IP             string           `json:"ip"`              // IPv4 assigned to the host
+	LeaseRemaining int64            `json:"lease_remaining"` // remaining lease time in seconds
+	AssignTime     Timestamp        `json:"assign_time"`     // timestamp of the lease first assignment
+	RefreshTime    Timestamp        `json:"refresh_time"`    // timestamp of the last lease refresh
+	IsStatic       bool             `json:"is_static"`       // is the lease a static lease
+	Host           LanInterfaceHost `json:"host"`            // LAN host information from LAN browser (refer to LanHost documentation)
+}
+
+// StaticLeasePayload returns the payload to create a static lease pinning the host to its current IP.
+func (l DHCPDynamicLease) StaticLeasePayload() DHCPStaticLeasePayload {
+	return DHCPStaticLeasePayload{
+		Mac:      l.Mac,
+		Hostname: l.Hostname,
+		IP:       l.IP,
+	}
+}
@@ -0,0 +1,109 @@
+package types
+
+import "strings"
+
+type downloadFileStatus string
+
+const (
+	DownloadFileStatusQueued      downloadFileStatus = "queued"      // File is queued
+	DownloadFileStatusError       downloadFileStatus = "error"       // There was an error downloading the file
+	DownloadFileStatusDone        downloadFileStatus = "done"        // File is downloaded
+	DownloadFileStatusDownloading downloadFileStatus = "downloading" // File is being downloaded
+)
+
+type downloadFilePriority string
+
+const (
+	DownloadFilePriorityNoDownload downloadFilePriority = "no_dl"  // File will not be downloaded
+	DownloadFilePriorityLow        downloadFilePriority = "low"    // Low priority
+	DownloadFilePriorityNormal     downloadFilePriority = "normal" // Normal priority
+	DownloadFilePriorityHigh       downloadFilePriority = "high"   // High priority
+)
+
+type DownloadFile struct {
+	ID         string               `json:"id"`          // file id
+	TaskID     int64                `json:"task_id"`     // id of the task the file belongs to
+	Path       Base64Path           `json:"path"`        // full path of the file on the disk
+	FilePath   string               `json:"filepath"`    // path of the file relative to the task download directory
+	Name       string               `json:"name"`        // name of the file
+	MimeType   string               `json:"mimetype"`    // mime type of the file
+	SizeBytes  int64                `json:"size"`        // file size (in Bytes)
+	RxBytes    int64                `json:"rx"`          // received bytes
+	Status     downloadFileStatus   `json:"status"`      // file status
+	Priority   downloadFilePriority `json:"priority"`    // download priority of the file
+	Error      downloadTaskError    `json:"error"`       // an error code
+	PreviewURL string               `json:"preview_url"` // URL to preview the file
+}
+
+type DownloadFileUpdate struct {
+	Priority downloadFilePriority `json:"priority"` // The new priority
+}
+
+type downloadTrackerStatus string
+
+const (
+	DownloadTrackerStatusUnknown         downloadTrackerStatus = "unknown"          // tracker has not been contacted yet
+	DownloadTrackerStatusAnnouncing      downloadTrackerStatus = "announcing"       // announce is in progress
+	DownloadTrackerStatusAnnounceOK      downloadTrackerStatus = "announce_ok"      // last announce succeeded
+	DownloadTrackerStatusAnnounceTimeout downloadTrackerStatus = "announce_timeout" // last announce timed out
+	DownloadTrackerStatusAnnounceError   downloadTrackerStatus = "announce_error"   // last announce failed
+	DownloadTrackerStatusDisabled        downloadTrackerStatus = "disabled"         // tracker is disabled
+)
+
+type DownloadTracker struct {
+	Announce            string                `json:"announce"`      // tracker announce URL
+	IsBackup            bool                  `json:"is_backup"`     // true if the tracker is a backup tracker
+	Status              downloadTrackerStatus `json:"status"`        // tracker status
+	IntervalSeconds     int64                 `json:"interval"`      // announce interval (in seconds)
+	MinIntervalSeconds  int64                 `json:"min_interval"`  // minimum announce interval (in seconds)
+	ReannounceInSeconds int64                 `json:"reannounce_in"` // seconds before the next announce
+	Seeders             int64                 `json:"nseeders"`      // number of seeders announced by the tracker
+	Leechers            int64                 `json:"nleechers"`     // number of leechers announced by the tracker
+	Enabled             bool                  `json:"is_enabled"`    // true if the tracker is enabled
+}
+
+type DownloadTrackerUpdate struct {
+	Enabled bool `json:"is_enabled"` // Enable or disable the tracker
+}
+
+type DownloadPeer struct {
+	Host            string  `json:"host"`             // peer IP address
+	Port            int64   `json:"port"`             // peer port
+	State           string  `json:"state"`            // peer connection state
+	Origin          string  `json:"origin"`           // how the peer was found (tracker, dht, pex, incoming)
+	Protocol        string  `json:"protocol"`         // protocol used to connect to the peer (tcp, utp)
+	Client          string  `json:"client"`           // peer client name
+	Country         string  `json:"country"`          // peer country code
+	TxBytes         int64   `json:"tx_bytes"`         // bytes sent to the peer
+	RxBytes         int64   `json:"rx_bytes"`         // bytes received from the peer
+	TxRate          int64   `json:"tx_rate"`          // current transmit rate to the peer (in byte/s)
+	RxRate          int64   `json:"rx_rate"`          // current receive rate from the peer (in byte/s)
+	Progress        float64 `json:"progress"`         // download progress of the peer
+	Requested       bool    `json:"requested"`        // true if a piece has been requested to the peer
+	LocalInterested bool    `json:"local_interested"` // true if we are interested in the peer pieces
+	LocalChoked     bool    `json:"local_choked"`     // true if we are choking the peer
+	PeerInterested  bool    `json:"peer_interested"`  // true if the peer is interested in our pieces
+	PeerChoked      bool    `json:"peer_choked"`      // true if the peer is choking us
+}
+
+type DownloadPieceState = rune
+
+const (
+	DownloadPieceStateDone        DownloadPieceState = 'X' // piece is downloaded
+	DownloadPieceStateMissing     DownloadPieceState = '.' // piece is not downloaded yet
+	DownloadPieceStateDownloading DownloadPieceState = '/' // piece is being downloaded
+	DownloadPieceStateUnwanted    DownloadPieceState = 'U' // piece is not wanted
+)
+
+// DownloadPieces is the bitmap of the pieces of a task, one DownloadPieceState per piece.
+type DownloadPieces string
+
+// Count returns the number of pieces in the given state.
+func (p DownloadPieces) Count(state DownloadPieceState) int {
+	return strings.Count(string(p), string(state))
+}
+
+type DownloadBlacklistEntry struct {
+	Host   string `json:"host"`   // blacklisted IP address
+	Expire int64  `json:"expire"` // seconds before the host is removed from the blacklist
+}
@@ -162,3 +162,17 @@ type ExtractFilePayload struct {
 	DeleteArchive bool       `json:"delete_archive"`
 	Overwrite     bool       `json:"overwrite"`
 }
+
+type ConcatenateFilesPayload struct {
+	Files        []Base64Path `json:"files"`         // List of files to concatenate
+	Dst          Base64Path   `json:"dst"`           // Destination file
+	MultiVolumes bool         `json:"multi_volumes"` // Set to true if the source files are volumes of a multi volume archive
+	DeleteFiles  bool         `json:"delete_files"`  // Delete the source files once the concatenation is done
+	Overwrite    bool         `json:"overwrite"`     // Overwrite the destination file if it exists
+	Append       bool         `json:"append"`        // Append to the destination file if it exists
+}
+
+type CreateArchivePayload struct {
+	Files []Base64Path `json:"files"` // List of files to add to the archive
+	Dst   Base64Path   `json:"dst"`   // Archive to create, its format is determined by its extension
+}
@@ -0,0 +1,111 @@
+package types
+
+type FreeplugNetRole = string
+
+const (
+	FreeplugNetRoleStation     FreeplugNetRole = "sta" // Freeplug is a station
+	FreeplugNetRoleProxy       FreeplugNetRole = "pco" // Freeplug is a proxy coordinator
+	FreeplugNetRoleCoordinator FreeplugNetRole = "cco" // Freeplug is the central coordinator of its network
+)
+
+type FreeplugEthPortStatus = string
+
+const (
+	FreeplugEthPortStatusUp      FreeplugEthPortStatus = "up"      // Ethernet link is up
+	FreeplugEthPortStatusDown    FreeplugEthPortStatus = "down"    // Ethernet link is down
+	FreeplugEthPortStatusUnknown FreeplugEthPortStatus = "unknown" // Ethernet link status is unknown
+)
+
+// FreeplugRateUnavailable is the value of the tx_rate and rx_rate fields when the rate is not known.
+const FreeplugRateUnavailable = -1
+
+type Freeplug struct {
+	ID            string                `json:"id"`              // Freeplug id (its MAC address)
+	Local         bool                  `json:"local"`           // If true, the Freeplug is connected directly to the Freebox
+	NetRole       FreeplugNetRole       `json:"net_role"`        // Role of the Freeplug in its network
+	NetID         string                `json:"net_id"`          // Id of the network the Freeplug belongs to
+	Model         string                `json:"model"`           // Freeplug model
+	HasNetwork    bool                  `json:"has_network"`     // If true, the Freeplug is part of a network
+	Inactive      int64                 `json:"inactive"`        // Seconds since the last activity of the Freeplug
+	EthPortStatus FreeplugEthPortStatus `json:"eth_port_status"` // Status of the Ethernet port
+	EthFullDuplex bool                  `json:"eth_full_duplex"` // If true, the Ethernet link is full duplex
+	EthSpeed      int64                 `json:"eth_speed"`       // Ethernet link speed in Mb/s
+	TxRate        int64                 `json:"tx_rate"`         // Rate from the Freeplug to the coordinator in Mb/s, -1 if not available
+	RxRate        int64                 `json:"rx_rate"`         // Rate from the coordinator to the Freeplug in Mb/s, -1 if not available
+}
+
+type FreeplugNetwork struct {
+	ID      string     `json:"id"`      // Network id
+	Members []Freeplug `json:"members"` // Freeplugs of the network
+}
+
+// FreeplugLink is the powerline link between a Freeplug and the coordinator of its network.
+type FreeplugLink struct {
+	NetworkID string // Id of the network of the link
+	From      string // Id of the Freeplug
+	To        string // Id of the coordinator of the network
+	TxRate    int64  // Rate from the Freeplug to the coordinator in Mb/s, -1 if not available
+	RxRate    int64  // Rate from the coordinator to the Freeplug in Mb/s, -1 if not available
+}
+
+// FreeplugGraph is a flat view of the Freeplug networks.
+type FreeplugGraph struct {
+	Nodes map[string]Freeplug // Freeplugs by id
+	Links []FreeplugLink      // Links of every Freeplug to the coordinator of its network
+}
+
+// NewFreeplugGraph flattens the given networks into a graph. Networks without coordinator do not have any link.
+func NewFreeplugGraph(networks []FreeplugNetwork) FreeplugGraph {
+	graph := FreeplugGraph{
+		Nodes: make(map[string]Freeplug),
+		Links: make([]FreeplugLink, 0),
+	}
+
+	for _, network := range networks {
+		coordinator := ""
+
+		for _, member := range network.Members {
+			graph.Nodes[member.ID] = member
+			if member.NetRole == FreeplugNetRoleCoordinator {
+				coordinator = member.ID
+			}
+		}
+
+		if coordinator == "" {
+			continue
+		}
+
+		for _, member := range network.Members {
+			if member.ID == coordinator {
+				continue
+			}
+
+			graph.Links = append(graph.Links, FreeplugLink{
+				NetworkID: network.ID,
+				From:      member.ID,
+				To:        coordinator,
+				TxRate:    member.TxRate,
+				RxRate:    member.RxRate,
+			})
+		}
+	}
+
+	return graph
+}
+
+// DegradedLinks returns the links with a known rate below the given one, in Mb/s.
+func (g FreeplugGraph) DegradedLinks(minRate int64) []FreeplugLink {
+	degraded := make([]FreeplugLink, 0)
+
+	for _, link := range g.Links {
+		if isDegradedFreeplugRate(link.TxRate, minRate) || isDegradedFreeplugRate(link.RxRate, minRate) {
+			degraded = append(degraded, link)
+		}
+	}
+
+	return degraded
+}
+
+func isDegradedFreeplugRate(rate, minRate int64) bool {
+	return rate != FreeplugRateUnavailable && rate < minRate
+}
@@ -0,0 +1,47 @@
+package types_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("freeplug", func() {
+	Describe("FreeplugGraph", func() {
+		var graph types.FreeplugGraph
+		BeforeEach(func() {
+			graph = types.NewFreeplugGraph([]types.FreeplugNetwork{
+				{
+					ID: "F4:CA:E5:1D:46:AE",
+					Members: []types.Freeplug{
+						{ID: "F4:CA:E5:1D:46:AE", NetRole: types.FreeplugNetRoleCoordinator, Local: true, TxRate: -1, RxRate: -1},
+						{ID: "14:0C:76:8A:3B:21", NetRole: types.FreeplugNetRoleStation, TxRate: 120, RxRate: 130},
+						{ID: "14:0C:76:8A:3B:22", NetRole: types.FreeplugNetRoleStation, TxRate: 12, RxRate: -1},
+					},
+				},
+				{
+					ID: "orphan",
+					Members: []types.Freeplug{
+						{ID: "14:0C:76:8A:3B:23", NetRole: types.FreeplugNetRoleStation, TxRate: -1, RxRate: -1},
+					},
+				},
+			})
+		})
+		It("should index every freeplug", func() {
+			Expect(graph.Nodes).To(HaveLen(4))
+			Expect(graph.Nodes["F4:CA:E5:1D:46:AE"].Local).To(BeTrue())
+		})
+		It("should link every member to the coordinator of its network", func() {
+			Expect(graph.Links).To(Equal([]types.FreeplugLink{
+				{NetworkID: "F4:CA:E5:1D:46:AE", From: "14:0C:76:8A:3B:21", To: "F4:CA:E5:1D:46:AE", TxRate: 120, RxRate: 130},
+				{NetworkID: "F4:CA:E5:1D:46:AE", From: "14:0C:76:8A:3B:22", To: "F4:CA:E5:1D:46:AE", TxRate: 12, RxRate: -1},
+			}))
+		})
+		It("should return the links with a known rate below the threshold", func() {
+			Expect(graph.DegradedLinks(100)).To(Equal([]types.FreeplugLink{graph.Links[1]}))
+			Expect(graph.DegradedLinks(10)).To(BeEmpty())
+			Expect(graph.DegradedLinks(125)).To(HaveLen(2))
+		})
+	})
+})
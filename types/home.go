@@ -0,0 +1,220 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// HomeEndpointType is the direction of a home endpoint.
+type HomeEndpointType = string
+
+const (
+	HomeEndpointTypeSignal HomeEndpointType = "signal" // Endpoint the node emits values on (read)
+	HomeEndpointTypeSlot   HomeEndpointType = "slot"   // Endpoint the node accepts values on (write)
+)
+
+// HomeEndpointValueType is the type of the value carried by a home endpoint.
+type HomeEndpointValueType = string
+
+const (
+	HomeEndpointValueTypeBool   HomeEndpointValueType = "bool"   // Boolean
+	HomeEndpointValueTypeInt    HomeEndpointValueType = "int"    // Integer
+	HomeEndpointValueTypeFloat  HomeEndpointValueType = "float"  // Floating point number
+	HomeEndpointValueTypeString HomeEndpointValueType = "string" // String
+	HomeEndpointValueTypeVoid   HomeEndpointValueType = "void"   // No value (action trigger)
+)
+
+// HomeEndpointVisibility is the visibility of a home endpoint in the Freebox UI.
+type HomeEndpointVisibility = string
+
+const (
+	HomeEndpointVisibilityInternal  HomeEndpointVisibility = "internal"  // Never displayed
+	HomeEndpointVisibilityNormal    HomeEndpointVisibility = "normal"    // Displayed
+	HomeEndpointVisibilityDashboard HomeEndpointVisibility = "dashboard" // Displayed on the dashboard
+)
+
+// HomeEndpointValue is the value of a home endpoint. The API returns values
+// of different JSON types depending on ValueType, hence the raw storage and
+// the typed accessors.
+type HomeEndpointValue struct {
+	NodeID     int64                 `json:"node_id,omitempty"` // Node id, only set on bulk-get results
+	EndpointID int64                 `json:"ep_id,omitempty"`   // Endpoint id, only set on bulk-get results
+	Value      json.RawMessage       `json:"value"`             // Raw value, see the typed accessors
+	ValueType  HomeEndpointValueType `json:"value_type"`        // Type of the value
+	Refresh    int64                 `json:"refresh,omitempty"` // Refresh interval of the value, in milliseconds
+}
+
+func (v *HomeEndpointValue) BoolValue() (bool, error) {
+	var result bool
+	if err := v.decode(HomeEndpointValueTypeBool, &result); err != nil {
+		return false, err
+	}
+
+	return result, nil
+}
+
+func (v *HomeEndpointValue) IntValue() (int64, error) {
+	var result int64
+	if err := v.decode(HomeEndpointValueTypeInt, &result); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+func (v *HomeEndpointValue) FloatValue() (float64, error) {
+	var result float64
+	if err := v.decode(HomeEndpointValueTypeFloat, &result); err != nil {
+		return 0, err
+	}
+
+	return result, nil
+}
+
+func (v *HomeEndpointValue) StringValue() (string, error) {
+	var result string
+	if err := v.decode(HomeEndpointValueTypeString, &result); err != nil {
+		return "", err
+	}
+
+	return result, nil
+}
+
+func (v *HomeEndpointValue) decode(expected HomeEndpointValueType, target interface{}) error {
+	if v.ValueType != expected {
+		return fmt.Errorf("unexpected value type: %s", v.ValueType)
+	}
+
+	if err := json.Unmarshal(v.Value, target); err != nil {
+		return fmt.Errorf("unmarshal %s value: %w", expected, err)
+	}
+
+	return nil
+}
+
+// HomeEndpointValuePayload is the payload to set the value of a home endpoint.
+// Endpoint: PUT /home/endpoints/{node_id}/{endpoint_id}
+type HomeEndpointValuePayload struct {
+	Value interface{} `json:"value"` // New value, of the type expected by the endpoint (nil for void endpoints)
+}
+
+// HomeEndpointReference identifies an endpoint of a node, for bulk-get requests.
+type HomeEndpointReference struct {
+	NodeID     int64 `json:"node_id"` // Node id
+	EndpointID int64 `json:"ep_id"`   // Endpoint id
+}
+
+// HomeEndpoint is an endpoint exposed by a home node.
+type HomeEndpoint struct {
+	HomeEndpointValue
+	ID         int64                  `json:"id"`         // Endpoint id
+	Type       HomeEndpointType       `json:"ep_type"`    // Endpoint direction
+	Name       string                 `json:"name"`       // Endpoint name
+	Label      string                 `json:"label"`      // Endpoint label
+	Category   string                 `json:"category"`   // Endpoint category
+	Visibility HomeEndpointVisibility `json:"visibility"` // Endpoint visibility
+}
+
+// HomeNodeStatus is the status of a home node.
+type HomeNodeStatus = string
+
+const (
+	HomeNodeStatusUnreachable HomeNodeStatus = "unreachable" // Node cannot be reached
+	HomeNodeStatusDisabled    HomeNodeStatus = "disabled"    // Node is disabled
+	HomeNodeStatusActive      HomeNodeStatus = "active"      // Node is active
+	HomeNodeStatusUnpaired    HomeNodeStatus = "unpaired"    // Node is not paired
+)
+
+// HomeNodeGroup is the group (room) a home node belongs to.
+type HomeNodeGroup struct {
+	Label string `json:"label"` // Group label
+}
+
+// HomeNodeType describes the kind of device a home node is.
+type HomeNodeType struct {
+	Name     string `json:"name"`     // Type name
+	Label    string `json:"label"`    // Type label
+	Icon     string `json:"icon"`     // Type icon
+	Abstract bool   `json:"abstract"` // If true, this type cannot be instantiated
+	Generic  bool   `json:"generic"`  // If true, this is a generic type
+	Physical bool   `json:"physical"` // If true, this is a physical device
+	Inherit  string `json:"inherit"`  // Parent type
+}
+
+// HomeNodeLink is a link between an endpoint of a node and an endpoint of another node.
+type HomeNodeLink struct {
+	NodeID     int64 `json:"node_id"` // Linked node id
+	EndpointID int64 `json:"ep_id"`   // Linked endpoint id
+}
+
+// HomeNode is a home automation device (sensor, alarm, camera, ...).
+type HomeNode struct {
+	ID            int64                  `json:"id"`             // Node id
+	AdapterID     int64                  `json:"adapter"`        // Id of the adapter the node is attached to
+	Category      string                 `json:"category"`       // Node category (alarm, pir, dws, kfb, camera, ...)
+	Group         HomeNodeGroup          `json:"group"`          // Group the node belongs to
+	Label         string                 `json:"label"`          // Node label
+	Name          string                 `json:"name"`           // Node name
+	Status        HomeNodeStatus         `json:"status"`         // Node status
+	Type          HomeNodeType           `json:"type"`           // Node type
+	Props         map[string]interface{} `json:"props"`          // Node properties
+	ShowEndpoints []HomeEndpoint         `json:"show_endpoints"` // Endpoints exposed by the node
+	SignalLinks   []HomeNodeLink         `json:"signal_links"`   // Links from the node signals
+	SlotLinks     []HomeNodeLink         `json:"slot_links"`     // Links to the node slots
+}
+
+// HomeNodePayload is the update payload for a home node.
+// Endpoint: PUT /home/nodes/{id}
+type HomeNodePayload struct {
+	Label string         `json:"label,omitempty"` // Node label
+	Group *HomeNodeGroup `json:"group,omitempty"` // Group the node belongs to
+}
+
+// HomeAdapterStatus is the status of a home adapter.
+type HomeAdapterStatus = string
+
+const (
+	HomeAdapterStatusActive   HomeAdapterStatus = "active"   // Adapter is active
+	HomeAdapterStatusDisabled HomeAdapterStatus = "disabled" // Adapter is disabled
+	HomeAdapterStatusError    HomeAdapterStatus = "error"    // Adapter is in error
+)
+
+// HomeAdapter is a radio adapter home nodes are attached to.
+type HomeAdapter struct {
+	ID          int64             `json:"id"`           // Adapter id
+	Label       string            `json:"label"`        // Adapter label
+	DefaultName string            `json:"default_name"` // Adapter default name
+	Icon        string            `json:"icon"`         // Adapter icon
+	Status      HomeAdapterStatus `json:"status"`       // Adapter status
+	UID         string            `json:"uid"`          // Adapter unique identifier
+}
+
+// HomeLink is a link between home nodes (e.g. a remote control driving an alarm).
+type HomeLink struct {
+	ID        int64  `json:"id"`       // Link id
+	AdapterID int64  `json:"adapter"`  // Id of the adapter the link belongs to
+	Label     string `json:"label"`    // Link label
+	Category  string `json:"category"` // Link category
+	Status    string `json:"status"`   // Link status
+}
+
+// HomeAlarmState is the state of the alarm security module.
+type HomeAlarmState = string
+
+const (
+	HomeAlarmStateIdle         HomeAlarmState = "idle"          // Alarm is disarmed
+	HomeAlarmStateArmingMain   HomeAlarmState = "alarm1_arming" // Main alarm is arming
+	HomeAlarmStateArmedMain    HomeAlarmState = "alarm1_armed"  // Main alarm is armed
+	HomeAlarmStateArmingSecond HomeAlarmState = "alarm2_arming" // Secondary alarm is arming
+	HomeAlarmStateArmedSecond  HomeAlarmState = "alarm2_armed"  // Secondary alarm is armed
+	HomeAlarmStateAlertTimer   HomeAlarmState = "alert_timer"   // An alert was triggered, the alarm will ring after a delay
+	HomeAlarmStateAlert        HomeAlarmState = "alert"         // Alarm is ringing
+)
+
+// HomeSecurityModule is the alarm security module of the Freebox.
+// Undocumented: reverse engineered from the Freebox OS web UI.
+type HomeSecurityModule struct {
+	NodeID int64          `json:"node_id"` // Id of the alarm node
+	State  HomeAlarmState `json:"state"`   // Current alarm state
+	Label  string         `json:"label"`   // Alarm label
+}
@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// HomePairingStatus is the status of a home pairing session.
+// Undocumented: reverse engineered from the Freebox OS web UI.
+type HomePairingStatus = string
+
+const (
+	HomePairingStatusIdle      HomePairingStatus = "idle"      // No pairing in progress
+	HomePairingStatusSearching HomePairingStatus = "searching" // Waiting for a device to announce itself
+	HomePairingStatusWaiting   HomePairingStatus = "waiting"   // Waiting for the user to fill the current page
+	HomePairingStatusPairing   HomePairingStatus = "pairing"   // Pairing with the device
+	HomePairingStatusDone      HomePairingStatus = "done"      // Pairing succeeded
+	HomePairingStatusFailed    HomePairingStatus = "failed"    // Pairing failed
+)
+
+// HomePairingField is an input requested by a pairing page.
+type HomePairingField struct {
+	Name  string          `json:"name"`  // Field name, used as key when advancing
+	Label string          `json:"label"` // Field label
+	Type  string          `json:"type"`  // Field type (string, int, bool, ...)
+	Value json.RawMessage `json:"value"` // Current or default value
+}
+
+// HomePairingPage is a step of the pairing wizard.
+type HomePairingPage struct {
+	ID     int64              `json:"id"`     // Page id
+	Title  string             `json:"title"`  // Page title
+	Fields []HomePairingField `json:"fields"` // Inputs expected to advance to the next page
+}
+
+// HomePairingState is the state of a home pairing session on an adapter.
+// Endpoint: GET /home/pairing/{adapter_id}
+type HomePairingState struct {
+	Status HomePairingStatus `json:"state"`           // Status of the session
+	Step   int64             `json:"step"`            // Index of the current step
+	Page   *HomePairingPage  `json:"page,omitempty"`  // Current page, if user input is expected
+	NodeID int64             `json:"node_id"`         // Id of the paired node, once done
+	Error  string            `json:"error,omitempty"` // Error code, when failed or when the input of the page was rejected
+}
+
+// HomePairingAdvance is the input submitted to move a pairing session to its next step.
+type HomePairingAdvance struct {
+	PageID int64                  `json:"page_id"` // Id of the page being submitted
+	Fields map[string]interface{} `json:"fields"`  // Values of the page fields, by name
+}
+
+// HomePairingOptions configures a pairing session driven by PairHomeDevice.
+type HomePairingOptions struct {
+	// PollInterval is the delay between two reads of the pairing state.
+	// Defaults to client.HomePairingPollInterval when zero.
+	PollInterval time.Duration
+	// Advance is called each time the session waits for user input,
+	// including when the box rejects the previous input of a page by
+	// reporting an error or by coming back to it. It returns the values
+	// submitted for the current page. When nil, the default values
+	// suggested by the box for the fields of the page are submitted.
+	Advance func(HomePairingState) (HomePairingAdvance, error)
+}
+
+// HomePairingEvent is a progress report of a pairing session.
+type HomePairingEvent struct {
+	State HomePairingState
+	Error error
+}
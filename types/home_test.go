@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("home", func() {
+	Describe("HomeEndpointValue", func() {
+		var value *types.HomeEndpointValue
+		BeforeEach(func() {
+			value = new(types.HomeEndpointValue)
+		})
+		Context("when the value is a bool", func() {
+			BeforeEach(func() {
+				Expect(json.Unmarshal([]byte(`{"value": true, "value_type": "bool"}`), value)).To(Succeed())
+			})
+			It("should return the value from BoolValue", func() {
+				Expect(value.BoolValue()).To(BeTrue())
+			})
+			It("should return an error from the other accessors", func() {
+				_, err := value.IntValue()
+				Expect(err).To(MatchError("unexpected value type: bool"))
+				_, err = value.FloatValue()
+				Expect(err).To(HaveOccurred())
+				_, err = value.StringValue()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("when the value is an int", func() {
+			BeforeEach(func() {
+				Expect(json.Unmarshal([]byte(`{"value": 42, "value_type": "int"}`), value)).To(Succeed())
+			})
+			It("should return the value from IntValue", func() {
+				Expect(value.IntValue()).To(Equal(int64(42)))
+			})
+			It("should return an error from BoolValue", func() {
+				_, err := value.BoolValue()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+		Context("when the value is a float", func() {
+			BeforeEach(func() {
+				Expect(json.Unmarshal([]byte(`{"value": 21.5, "value_type": "float"}`), value)).To(Succeed())
+			})
+			It("should return the value from FloatValue", func() {
+				Expect(value.FloatValue()).To(Equal(21.5))
+			})
+		})
+		Context("when the value is a string", func() {
+			BeforeEach(func() {
+				Expect(json.Unmarshal([]byte(`{"value": "alarm1_armed", "value_type": "string"}`), value)).To(Succeed())
+			})
+			It("should return the value from StringValue", func() {
+				Expect(value.StringValue()).To(Equal(types.HomeAlarmStateArmedMain))
+			})
+		})
+		Context("when the value does not match its declared type", func() {
+			BeforeEach(func() {
+				Expect(json.Unmarshal([]byte(`{"value": "yes", "value_type": "bool"}`), value)).To(Succeed())
+			})
+			It("should return an error", func() {
+				_, err := value.BoolValue()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
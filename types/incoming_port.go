@@ -0,0 +1,25 @@
+package types
+
+type IncomingPortID = string
+
+const (
+	IncomingPortHTTP           IncomingPortID = "http"            // Remote access to Freebox OS over HTTP
+	IncomingPortHTTPS          IncomingPortID = "https"           // Remote access to Freebox OS over HTTPS
+	IncomingPortFTP            IncomingPortID = "ftp"             // Remote access to the FTP server
+	IncomingPortBittorrentMain IncomingPortID = "bittorrent-main" // Main port of the BitTorrent client
+	IncomingPortBittorrentDHT  IncomingPortID = "bittorrent-dht"  // DHT port of the BitTorrent client
+)
+
+type IncomingPortPayload struct {
+	Enabled *bool `json:"enabled,omitempty"` // is the incoming port enabled
+	InPort  int64 `json:"in_port,omitempty"` // incoming port number on the public IPv4 address
+}
+
+type IncomingPort struct {
+	IncomingPortPayload
+	ID         IncomingPortID `json:"id"`       // incoming port id
+	Active     bool           `json:"active"`   // is the service behind the incoming port running (read-only)
+	IPProtocol ipProtocol     `json:"type"`     // incoming port protocol (read-only)
+	Readonly   bool           `json:"readonly"` // if true, the incoming port cannot be modified (read-only)
+	Netns      string         `json:"netns"`    // network namespace of the incoming port (read-only)
+}
@@ -117,6 +117,17 @@ func (o *LanInterfaceHost) UnmarshalJSON(data []byte) error {
 	return fmt.Errorf("failed to parse primary_name_manual field: '%s'", string(aux.PrimaryNameManual))
 }
 
+type LanInterfaceHostPayload struct {
+	PrimaryName string   `json:"primary_name,omitempty"` // Host primary name
+	Type        hostType `json:"host_type,omitempty"`    // Host type
+	Persistent  *bool    `json:"persistent,omitempty"`   // If true the host is always shown even if it has not been active since the Freebox startup
+}
+
+type WakeOnLANPayload struct {
+	Mac      string `json:"mac"`      // Host mac address
+	Password string `json:"password"` // Wake on LAN password, or empty if the host does not require any
+}
+
 type LanHostAccessPoint struct {
 	RXBytes          int64                              `json:"rx_bytes"`
 	TXBytes          int64                              `json:"tx_bytes"`
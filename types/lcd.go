@@ -0,0 +1,17 @@
+package types
+
+type LCDOrientation = int64
+
+const (
+	LCDOrientation0   LCDOrientation = 0   // Default orientation
+	LCDOrientation90  LCDOrientation = 90  // Rotated by 90 degrees
+	LCDOrientation180 LCDOrientation = 180 // Upside down
+	LCDOrientation270 LCDOrientation = 270 // Rotated by 270 degrees
+)
+
+type LCDConfiguration struct {
+	Brightness        int64          `json:"brightness"`         // Screen brightness, in percent
+	Orientation       LCDOrientation `json:"orientation"`        // Screen orientation in degrees
+	OrientationForced bool           `json:"orientation_forced"` // If true, the orientation is not read from the orientation sensor
+	HideWifiKey       bool           `json:"hide_wifi_key"`      // If true, the Wi-Fi key is not displayed on the screen
+}
@@ -47,3 +47,18 @@ type NetworkControlPayload struct {
 	Resolution int `json:"resolution,omitempty"` // Control resolution per day of this network control.
 	CustomDayRanges[]DayRange `json:"cdayranges"` // list of custom day range, each custom day range represents a group of days for which you want to use a different planning than other week days.
 }
+
+type NetworkControlRulePayload struct {
+	Enabled   bool     `json:"enabled"`    // Whether the rule is enabled.
+	Name      string   `json:"name"`       // Name of the rule.
+	Mode      RuleMode `json:"mode"`       // Mode applied while the rule is active.
+	StartTime int64    `json:"start_time"` // Start of the rule, in seconds since midnight.
+	EndTime   int64    `json:"end_time"`   // End of the rule, in seconds since midnight.
+	Weekdays  []bool   `json:"weekdays"`   // 7-element array of the days the rule applies on, starting Monday.
+}
+
+type NetworkControlRule struct {
+	NetworkControlRulePayload
+	ID        int64     `json:"id"`         // Id of the rule.
+	ProfileID ProfileID `json:"profile_id"` // Id of the profile this rule is attached to.
+}
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+const daysPerWeek = 7
+
+// ParentalConfig is the global parental filter configuration.
+type ParentalConfig struct {
+	DefaultFilterMode RuleMode `json:"default_filter_mode"` // Mode applied to the hosts that do not match any filter rule
+}
+
+// ParentalFilterPayload is the create/update payload for a parental filter rule.
+type ParentalFilterPayload struct {
+	Macs          []string   `json:"macs"`                      // MAC addresses of the hosts the rule applies to
+	Description   string     `json:"desc"`                      // Rule description
+	Forced        bool       `json:"forced"`                    // If true, the forced mode is applied instead of the planning
+	ForcedMode    RuleMode   `json:"forced_mode,omitempty"`     // Mode applied when the rule is forced
+	TmpMode       RuleMode   `json:"tmp_mode,omitempty"`        // Mode temporarily applied until tmp_mode_expire
+	TmpModeExpire *Timestamp `json:"tmp_mode_expire,omitempty"` // End of the temporary mode
+}
+
+// ParentalFilter is a parental filter rule.
+type ParentalFilter struct {
+	ParentalFilterPayload
+	ID            int64    `json:"id"`             // Rule id
+	CurrentMode   RuleMode `json:"current_mode"`   // Mode currently in use (read-only)
+	ScheduledMode RuleMode `json:"scheduled_mode"` // Mode the planning schedules at the moment (read-only)
+}
+
+// ParentalFilterPlanning is the weekly planning of a parental filter rule. Use NewParentalFilterPlanning,
+// Mode and SetMode rather than manipulating the mapping directly.
+type ParentalFilterPlanning struct {
+	ID              int64      `json:"id,omitempty"`         // Id of the rule the planning belongs to (read-only)
+	Resolution      int64      `json:"resolution"`           // Number of slots per day
+	Mapping         []RuleMode `json:"mapping"`              // Mode for each slot, starting Monday midnight, followed by the slots of the custom day ranges
+	CustomDayRanges []DayRange `json:"cdayranges,omitempty"` // Custom day ranges using their own daily planning
+}
+
+// NewParentalFilterPlanning returns a planning with the given number of slots per day, applying the given mode all week long.
+func NewParentalFilterPlanning(resolution int64, mode RuleMode) ParentalFilterPlanning {
+	mapping := make([]RuleMode, daysPerWeek*resolution)
+	for index := range mapping {
+		mapping[index] = mode
+	}
+
+	return ParentalFilterPlanning{
+		Resolution: resolution,
+		Mapping:    mapping,
+	}
+}
+
+// SlotDuration returns the duration covered by a single slot of the planning.
+func (p ParentalFilterPlanning) SlotDuration() time.Duration {
+	if p.Resolution <= 0 {
+		return 0
+	}
+
+	return time.Hour * 24 / time.Duration(p.Resolution)
+}
+
+// Mode returns the mode scheduled on the given day, at the given offset from midnight.
+func (p ParentalFilterPlanning) Mode(day time.Weekday, offset time.Duration) (RuleMode, error) {
+	if err := p.validate(); err != nil {
+		return "", err
+	}
+
+	if offset < 0 || offset >= time.Hour*24 {
+		return "", fmt.Errorf("offset %s is not within a day", offset)
+	}
+
+	return p.Mapping[p.slot(day, offset)], nil
+}
+
+// ModeAt returns the mode scheduled at the given time, in the time location.
+func (p ParentalFilterPlanning) ModeAt(at time.Time) (RuleMode, error) {
+	midnight := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+
+	return p.Mode(at.Weekday(), at.Sub(midnight))
+}
+
+// SetMode schedules the given mode on the given day, between the from and to offsets from midnight.
+// Both offsets must be aligned on the slot duration, and to may be 24 hours to cover the end of the day.
+func (p *ParentalFilterPlanning) SetMode(day time.Weekday, from, to time.Duration, mode RuleMode) error {
+	if err := p.validate(); err != nil {
+		return err
+	}
+
+	if from < 0 || to > time.Hour*24 || from >= to {
+		return fmt.Errorf("invalid range from %s to %s", from, to)
+	}
+
+	slotDuration := p.SlotDuration()
+	if from%slotDuration != 0 || to%slotDuration != 0 {
+		return fmt.Errorf("range from %s to %s is not aligned on %s slots", from, to, slotDuration)
+	}
+
+	start := p.slot(day, from)
+	end := start + int64((to-from)/slotDuration)
+
+	for index := start; index < end; index++ {
+		p.Mapping[index] = mode
+	}
+
+	return nil
+}
+
+func (p ParentalFilterPlanning) validate() error {
+	if p.Resolution <= 0 || (time.Hour*24)%time.Duration(p.Resolution) != 0 {
+		return fmt.Errorf("invalid resolution: %d", p.Resolution)
+	}
+
+	if int64(len(p.Mapping)) < daysPerWeek*p.Resolution {
+		return fmt.Errorf("mapping has %d slots, expected at least %d", len(p.Mapping), daysPerWeek*p.Resolution)
+	}
+
+	return nil
+}
+
+// slot returns the index in the mapping of the slot containing the given offset, weeks starting on Monday.
+func (p ParentalFilterPlanning) slot(day time.Weekday, offset time.Duration) int64 {
+	return int64((day+daysPerWeek-1)%daysPerWeek)*p.Resolution + int64(offset/p.SlotDuration())
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/nikolalohinski/free-go/types"
+)
+
+var _ = Describe("parental", func() {
+	Describe("ParentalFilterPlanning", func() {
+		var planning types.ParentalFilterPlanning
+		BeforeEach(func() {
+			planning = types.NewParentalFilterPlanning(48, types.RuleModeAllowed)
+		})
+		It("should cover the whole week with the given mode", func() {
+			Expect(planning.Mapping).To(HaveLen(7 * 48))
+			Expect(planning.Mapping).To(HaveEach(types.RuleModeAllowed))
+			Expect(planning.SlotDuration()).To(Equal(30 * time.Minute))
+		})
+		Context("when setting a range", func() {
+			BeforeEach(func() {
+				Expect(planning.SetMode(time.Sunday, 22*time.Hour, 24*time.Hour, types.RuleModeDenied)).To(Succeed())
+				Expect(planning.SetMode(time.Monday, 0, 30*time.Minute, types.RuleModeWebOnly)).To(Succeed())
+			})
+			It("should update the matching slots only", func() {
+				Expect(planning.Mapping[0]).To(Equal(types.RuleModeWebOnly))
+				Expect(planning.Mapping[1]).To(Equal(types.RuleModeAllowed))
+				Expect(planning.Mapping[6*48+43]).To(Equal(types.RuleModeAllowed))
+				Expect(planning.Mapping[6*48+44:]).To(HaveEach(types.RuleModeDenied))
+			})
+			It("should return the scheduled modes", func() {
+				Expect(planning.Mode(time.Sunday, 23*time.Hour+59*time.Minute)).To(Equal(types.RuleModeDenied))
+				Expect(planning.Mode(time.Monday, 10*time.Minute)).To(Equal(types.RuleModeWebOnly))
+				Expect(planning.ModeAt(time.Date(2024, time.March, 17, 22, 15, 0, 0, time.UTC))).To(Equal(types.RuleModeDenied))
+				Expect(planning.ModeAt(time.Date(2024, time.March, 18, 12, 0, 0, 0, time.UTC))).To(Equal(types.RuleModeAllowed))
+			})
+		})
+		It("should reject ranges that are not aligned on slots", func() {
+			Expect(planning.SetMode(time.Monday, 10*time.Minute, time.Hour, types.RuleModeDenied)).To(MatchError(ContainSubstring("not aligned")))
+		})
+		It("should reject invalid ranges", func() {
+			Expect(planning.SetMode(time.Monday, time.Hour, time.Hour, types.RuleModeDenied)).ToNot(Succeed())
+			Expect(planning.SetMode(time.Monday, 0, 25*time.Hour, types.RuleModeDenied)).ToNot(Succeed())
+			_, err := planning.Mode(time.Monday, 24*time.Hour)
+			Expect(err).To(HaveOccurred())
+		})
+		It("should reject plannings with a truncated mapping", func() {
+			planning.Mapping = planning.Mapping[:10]
+			_, err := planning.Mode(time.Monday, 0)
+			Expect(err).To(MatchError(ContainSubstring("expected at least 336")))
+		})
+	})
+})
@@ -5,3 +5,8 @@ type Profile struct {
 	Name string    `json:"name"` // Name of the profile.
 	Icon string    `json:"icon"` // Path to the profile's icon.
 }
+
+type ProfilePayload struct {
+	Name string `json:"name"` // Name of the profile.
+	Icon string `json:"icon"` // Path to the profile's icon.
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+type ShareLink struct {
+	Token   string     // Token of the link
+	Path    Base64Path // Path of the shared file or directory
+	Name    string     // Name of the shared file or directory
+	Expire  time.Time  // Expiration date of the link, zero if the link never expires
+	FullURL string     // Public URL of the link
+}
+
+type shareLinkJSON struct {
+	Token   string     `json:"token,omitempty"`
+	Path    Base64Path `json:"path"`
+	Name    string     `json:"name,omitempty"`
+	Expire  int64      `json:"expire"`
+	FullURL string     `json:"fullurl,omitempty"`
+}
+
+func (l ShareLink) MarshalJSON() ([]byte, error) {
+	return json.Marshal(shareLinkJSON{ //nolint:wrapcheck
+		Token:   l.Token,
+		Path:    l.Path,
+		Name:    l.Name,
+		Expire:  expireToUnix(l.Expire),
+		FullURL: l.FullURL,
+	})
+}
+
+func (l *ShareLink) UnmarshalJSON(data []byte) error {
+	raw := shareLinkJSON{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("failed to unmarshal share link: %w", err)
+	}
+
+	*l = ShareLink{
+		Token:   raw.Token,
+		Path:    raw.Path,
+		Name:    raw.Name,
+		Expire:  expireFromUnix(raw.Expire),
+		FullURL: raw.FullURL,
+	}
+
+	return nil
+}
+
+// Expired reports whether the link has expired at the given time. Links without expiration date never expire.
+func (l ShareLink) Expired(now time.Time) bool {
+	return !l.Expire.IsZero() && !now.Before(l.Expire)
+}
+
+type ShareLinkPayload struct {
+	Path    Base64Path // Path of the file or directory to share
+	Expire  time.Time  // Expiration date of the link, leave zero for a link that never expires
+	FullURL string     // Optional public URL to use for the link instead of the default one
+}
+
+func (p ShareLinkPayload) MarshalJSON() ([]byte, error) {
+	return json.Marshal(shareLinkJSON{ //nolint:wrapcheck
+		Path:    p.Path,
+		Expire:  expireToUnix(p.Expire),
+		FullURL: p.FullURL,
+	})
+}
+
+// The API uses 0 for links that never expire.
+func expireToUnix(expire time.Time) int64 {
+	if expire.IsZero() {
+		return 0
+	}
+
+	return expire.Unix()
+}
+
+func expireFromUnix(expire int64) time.Time {
+	if expire == 0 {
+		return time.Time{}
+	}
+
+	return time.Unix(expire, 0).UTC()
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/nikolalohinski/free-go/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("share link", func() {
+	returnedErr := new(error)
+	Context("json marshal/unmarshal of share links", func() {
+		Context("when marshaling a payload", func() {
+			var (
+				payload types.ShareLinkPayload
+				bytes   []byte
+			)
+			BeforeEach(func() {
+				payload = types.ShareLinkPayload{
+					Path:   "/Freebox/Photos",
+					Expire: time.Unix(1663485940, 0),
+				}
+			})
+			JustBeforeEach(func() {
+				bytes, *returnedErr = json.Marshal(payload)
+			})
+			It("should encode the path and the expiration date", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(bytes).To(MatchJSON(`{"path": "L0ZyZWVib3gvUGhvdG9z", "expire": 1663485940}`))
+			})
+			Context("when the expiration date is not set", func() {
+				BeforeEach(func() {
+					payload.Expire = time.Time{}
+				})
+				It("should send 0 for a link that never expires", func() {
+					Expect(*returnedErr).To(BeNil())
+					Expect(bytes).To(MatchJSON(`{"path": "L0ZyZWVib3gvUGhvdG9z", "expire": 0}`))
+				})
+			})
+		})
+		Context("when unmarshaling", func() {
+			link := new(types.ShareLink)
+			var payload string
+			BeforeEach(func() {
+				payload = `{
+					"token": "ssJ1wBDm4ywPuTvD",
+					"path": "L0ZyZWVib3gvUGhvdG9z",
+					"name": "Photos",
+					"expire": 1663485940,
+					"fullurl": "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD"
+				}`
+			})
+			JustBeforeEach(func() {
+				*link = types.ShareLink{}
+				*returnedErr = json.Unmarshal([]byte(payload), link)
+			})
+			It("should decode the path and the expiration date", func() {
+				Expect(*returnedErr).To(BeNil())
+				Expect(*link).To(Equal(types.ShareLink{
+					Token:   "ssJ1wBDm4ywPuTvD",
+					Path:    "/Freebox/Photos",
+					Name:    "Photos",
+					Expire:  time.Unix(1663485940, 0).UTC(),
+					FullURL: "https://example.freeboxos.fr/share/ssJ1wBDm4ywPuTvD",
+				}))
+			})
+			Context("when the link never expires", func() {
+				BeforeEach(func() {
+					payload = `{"token": "ssJ1wBDm4ywPuTvD", "path": "L0ZyZWVib3gvUGhvdG9z", "expire": 0}`
+				})
+				It("should leave the expiration date unset", func() {
+					Expect(*returnedErr).To(BeNil())
+					Expect(link.Expire.IsZero()).To(BeTrue())
+					Expect(link.Expired(time.Now())).To(BeFalse())
+				})
+			})
+			Context("when the path is not valid base64", func() {
+				BeforeEach(func() {
+					payload = `{"token": "ssJ1wBDm4ywPuTvD", "path": "!!!", "expire": 0}`
+				})
+				It("should return an error", func() {
+					Expect(*returnedErr).ToNot(BeNil())
+				})
+			})
+		})
+	})
+	Context("checking if a share link has expired", func() {
+		link := types.ShareLink{Expire: time.Unix(1663485940, 0)}
+		It("should compare the expiration date with the given time", func() {
+			Expect(link.Expired(time.Unix(1663485939, 0))).To(BeFalse())
+			Expect(link.Expired(time.Unix(1663485940, 0))).To(BeTrue())
+			Expect(link.Expired(time.Unix(1663485941, 0))).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package types
+
+type SwitchPortLink = string
+
+const (
+	SwitchPortLinkUp   SwitchPortLink = "up"   // A device is connected to the port
+	SwitchPortLinkDown SwitchPortLink = "down" // No device is connected to the port
+)
+
+type SwitchPortDuplex = string
+
+const (
+	SwitchPortDuplexAuto SwitchPortDuplex = "auto" // Duplex mode is negotiated
+	SwitchPortDuplexHalf SwitchPortDuplex = "half" // Half duplex mode
+	SwitchPortDuplexFull SwitchPortDuplex = "full" // Full duplex mode
+)
+
+type SwitchPortSpeed = string
+
+const (
+	SwitchPortSpeedAuto SwitchPortSpeed = "auto" // Speed is negotiated
+	SwitchPortSpeed10   SwitchPortSpeed = "10"   // 10 Mb/s
+	SwitchPortSpeed100  SwitchPortSpeed = "100"  // 100 Mb/s
+	SwitchPortSpeed1000 SwitchPortSpeed = "1000" // 1 Gb/s
+)
+
+type SwitchPortMac struct {
+	Mac      string `json:"mac"`      // MAC address of the device seen on the port
+	Hostname string `json:"hostname"` // Hostname of the device
+}
+
+type SwitchPortStatus struct {
+	ID      int64            `json:"id"`       // Port id
+	Name    string           `json:"name"`     // Port name
+	Link    SwitchPortLink   `json:"link"`     // Link state of the port
+	Mode    string           `json:"mode"`     // Negotiated mode, for instance 1000BaseT-FD
+	Speed   SwitchPortSpeed  `json:"speed"`    // Current speed of the port
+	Duplex  SwitchPortDuplex `json:"duplex"`   // Current duplex mode of the port
+	RRDID   string           `json:"rrd_id"`   // Id of the port in the RRD database
+	MacList []SwitchPortMac  `json:"mac_list"` // Devices seen on the port
+}
+
+type SwitchPortConfigurationPayload struct {
+	Duplex SwitchPortDuplex `json:"duplex,omitempty"` // Duplex mode of the port
+	Speed  SwitchPortSpeed  `json:"speed,omitempty"`  // Speed of the port
+}
+
+type SwitchPortConfiguration struct {
+	SwitchPortConfigurationPayload
+	ID int64 `json:"id"` // Port id
+}
+
+type SwitchPortStats struct {
+	RxBadBytes         int64 `json:"rx_bad_bytes"`         // Bytes received in bad packets
+	RxBroadcastPackets int64 `json:"rx_broadcast_packets"` // Broadcast packets received
+	RxBytesRate        int64 `json:"rx_bytes_rate"`        // Receive rate in bytes per second
+	RxErrPackets       int64 `json:"rx_err_packets"`       // Packets received with errors
+	RxFCSPackets       int64 `json:"rx_fcs_packets"`       // Packets received with a bad frame check sequence
+	RxFragmentsPackets int64 `json:"rx_fragments_packets"` // Fragmented packets received
+	RxGoodBytes        int64 `json:"rx_good_bytes"`        // Bytes received in good packets
+	RxGoodPackets      int64 `json:"rx_good_packets"`      // Good packets received
+	RxJabberPackets    int64 `json:"rx_jabber_packets"`    // Jabber packets received
+	RxMulticastPackets int64 `json:"rx_multicast_packets"` // Multicast packets received
+	RxOversizePackets  int64 `json:"rx_oversize_packets"`  // Oversized packets received
+	RxPacketsRate      int64 `json:"rx_packets_rate"`      // Receive rate in packets per second
+	RxPause            int64 `json:"rx_pause"`             // Pause frames received
+	RxUndersizePackets int64 `json:"rx_undersize_packets"` // Undersized packets received
+	RxUnicastPackets   int64 `json:"rx_unicast_packets"`   // Unicast packets received
+	TxBroadcastPackets int64 `json:"tx_broadcast_packets"` // Broadcast packets sent
+	TxBytes            int64 `json:"tx_bytes"`             // Bytes sent
+	TxBytesRate        int64 `json:"tx_bytes_rate"`        // Transmit rate in bytes per second
+	TxCollisions       int64 `json:"tx_collisions"`        // Collisions while sending
+	TxDeferred         int64 `json:"tx_deferred"`          // Deferred transmissions
+	TxExcessive        int64 `json:"tx_excessive"`         // Transmissions aborted after excessive collisions
+	TxFCS              int64 `json:"tx_fcs"`               // Packets sent with a bad frame check sequence
+	TxLate             int64 `json:"tx_late"`              // Late collisions
+	TxMulticastPackets int64 `json:"tx_multicast_packets"` // Multicast packets sent
+	TxMultiple         int64 `json:"tx_multiple"`          // Packets sent after multiple collisions
+	TxPackets          int64 `json:"tx_packets"`           // Packets sent
+	TxPacketsRate      int64 `json:"tx_packets_rate"`      // Transmit rate in packets per second
+	TxPause            int64 `json:"tx_pause"`             // Pause frames sent
+	TxSingle           int64 `json:"tx_single"`            // Packets sent after a single collision
+	TxUnicastPackets   int64 `json:"tx_unicast_packets"`   // Unicast packets sent
+}
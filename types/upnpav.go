@@ -0,0 +1,5 @@
+package types
+
+type UPnPAVConfiguration struct {
+	Enabled bool `json:"enabled"` // is the UPnP AV media server enabled
+}
@@ -0,0 +1,279 @@
+package types
+
+// WifiMacFilterState is the global state of the Wi-Fi MAC filter.
+type WifiMacFilterState = string
+
+const (
+	WifiMacFilterStateDisabled  WifiMacFilterState = "disabled"  // MAC filter is disabled
+	WifiMacFilterStateWhitelist WifiMacFilterState = "whitelist" // Only whitelisted MAC addresses are allowed
+	WifiMacFilterStateBlacklist WifiMacFilterState = "blacklist" // Blacklisted MAC addresses are rejected
+)
+
+// WifiGlobalConfig is the global Wi-Fi configuration.
+// https://dev.freebox.fr/sdk/os/wifi/#wi-fi-global-configuration
+type WifiGlobalConfig struct {
+	Enabled        bool               `json:"enabled"`                    // Enable or disable Wi-Fi on every access point
+	MacFilterState WifiMacFilterState `json:"mac_filter_state,omitempty"` // State of the MAC filter
+}
+
+// WifiBand is the radio band of an access point.
+type WifiBand = string
+
+const (
+	WifiBand2G4 WifiBand = "2d4g" // 2.4 GHz
+	WifiBand5G  WifiBand = "5g"   // 5 GHz
+	WifiBand6G  WifiBand = "6g"   // 6 GHz
+	WifiBand60G WifiBand = "60g"  // 60 GHz
+)
+
+// WifiAccessPointState is the state of an access point.
+type WifiAccessPointState = string
+
+const (
+	WifiAccessPointStateScanning         WifiAccessPointState = "scanning"          // Scanning for the best channel
+	WifiAccessPointStateNoParam          WifiAccessPointState = "no_param"          // No configuration available
+	WifiAccessPointStateBadParam         WifiAccessPointState = "bad_param"         // Invalid configuration
+	WifiAccessPointStateDisabled         WifiAccessPointState = "disabled"          // Access point is disabled
+	WifiAccessPointStateDisabledPlanning WifiAccessPointState = "disabled_planning" // Access point is disabled by the planning
+	WifiAccessPointStateNoActiveBSS      WifiAccessPointState = "no_active_bss"     // No BSS is active on this access point
+	WifiAccessPointStateStarting         WifiAccessPointState = "starting"          // Access point is starting
+	WifiAccessPointStateACS              WifiAccessPointState = "acs"               // Automatic channel selection in progress
+	WifiAccessPointStateHT40Scan         WifiAccessPointState = "ht_scan"           // Scanning for HT40 compatibility
+	WifiAccessPointStateDFS              WifiAccessPointState = "dfs"               // Performing DFS channel availability check
+	WifiAccessPointStateActive           WifiAccessPointState = "active"            // Access point is active
+	WifiAccessPointStateFailed           WifiAccessPointState = "failed"            // Access point failed to start
+)
+
+// WifiAccessPointStatus is the current status of an access point (read-only).
+type WifiAccessPointStatus struct {
+	State               WifiAccessPointState `json:"state"`                  // State of the access point
+	ChannelWidth        string               `json:"channel_width"`          // Channel width in use, in MHz
+	PrimaryChannel      int64                `json:"primary_channel"`        // Primary channel in use
+	SecondaryChannel    int64                `json:"secondary_channel"`      // Secondary channel in use
+	DFSCACRemainingTime int64                `json:"dfs_cac_remaining_time"` // Remaining time of the DFS channel availability check, in seconds
+	DFSDisabled         bool                 `json:"dfs_disabled"`           // If true, DFS is disabled on this access point
+}
+
+// WifiHTConfig is the 802.11n (HT) configuration of an access point.
+type WifiHTConfig struct {
+	HTEnabled  bool  `json:"ht_enabled"`  // Enable 802.11n
+	ACEnabled  bool  `json:"ac_enabled"`  // Enable 802.11ac
+	ShortGI20  bool  `json:"shortgi20"`   // Short guard interval on 20 MHz channels
+	ShortGI40  bool  `json:"shortgi40"`   // Short guard interval on 40 MHz channels
+	Greenfield bool  `json:"greenfield"`  // Greenfield mode
+	DSSSCCK40  bool  `json:"dsss_cck_40"` // DSSS/CCK on 40 MHz channels
+	TXSTBC     bool  `json:"tx_stbc"`     // Transmit STBC
+	RXSTBC     int64 `json:"rx_stbc"`     // Receive STBC streams
+	LDPC       bool  `json:"ldpc"`        // LDPC coding
+}
+
+// WifiACConfig is the 802.11ac (VHT) configuration of an access point.
+type WifiACConfig struct {
+	ShortGI80  bool  `json:"shortgi80"`  // Short guard interval on 80 MHz channels
+	ShortGI160 bool  `json:"shortgi160"` // Short guard interval on 160 MHz channels
+	TXSTBC     bool  `json:"tx_stbc"`    // Transmit STBC
+	RXSTBC     int64 `json:"rx_stbc"`    // Receive STBC streams
+	LDPC       bool  `json:"ldpc"`       // LDPC coding
+}
+
+// WifiAccessPointConfig is the configuration of an access point.
+type WifiAccessPointConfig struct {
+	Band             WifiBand      `json:"band,omitempty"`          // Radio band
+	ChannelWidth     string        `json:"channel_width,omitempty"` // Channel width, in MHz ("20", "40", "80", "160")
+	PrimaryChannel   int64         `json:"primary_channel"`         // Primary channel, 0 for automatic selection
+	SecondaryChannel int64         `json:"secondary_channel"`       // Secondary channel, 0 for automatic selection
+	DFSEnabled       bool          `json:"dfs_enabled"`             // Allow DFS channels
+	HT               *WifiHTConfig `json:"ht,omitempty"`            // 802.11n configuration
+	AC               *WifiACConfig `json:"ac,omitempty"`            // 802.11ac configuration
+}
+
+// WifiAccessPoint is a Wi-Fi access point, usually one per radio.
+// https://dev.freebox.fr/sdk/os/wifi/#wi-fi-access-point
+type WifiAccessPoint struct {
+	ID           int64                        `json:"id"`           // Access point id (read-only)
+	Name         string                       `json:"name"`         // Access point name (read-only)
+	Status       WifiAccessPointStatus        `json:"status"`       // Current status (read-only)
+	Capabilities map[WifiBand]map[string]bool `json:"capabilities"` // Capabilities supported by the access point for each band (read-only)
+	Config       WifiAccessPointConfig        `json:"config"`       // Configuration of the access point
+}
+
+// WifiAllowedChannelCombination is a channel combination allowed on an access point.
+type WifiAllowedChannelCombination struct {
+	Band         WifiBand `json:"band"`          // Radio band
+	ChannelWidth string   `json:"channel_width"` // Channel width, in MHz
+	NeedDFS      bool     `json:"need_dfs"`      // If true, DFS must be enabled to use this combination
+	Primary      []int64  `json:"primary"`       // List of allowed primary channels
+	Secondary    []int64  `json:"secondary"`     // List of allowed secondary channels
+}
+
+// WifiStationState is the state of a station associated with an access point.
+type WifiStationState = string
+
+const (
+	WifiStationStateAssociating    WifiStationState = "associating"    // Association in progress
+	WifiStationStateAuthenticating WifiStationState = "authenticating" // Authentication in progress
+	WifiStationStateAuthenticated  WifiStationState = "authenticated"  // Authenticated
+)
+
+// WifiStationFlags are the capabilities of a station.
+type WifiStationFlags struct {
+	Legacy     bool `json:"legacy"`     // Station uses legacy 802.11a/b/g
+	HT         bool `json:"ht"`         // Station supports 802.11n
+	VHT        bool `json:"vht"`        // Station supports 802.11ac
+	Authorized bool `json:"authorized"` // Station is authorized to send traffic
+}
+
+// WifiStationStats are the statistics of the last frame exchanged with a station.
+type WifiStationStats struct {
+	Bitrate int64  `json:"bitrate"` // Bitrate, in 100 kbit/s
+	MCS     int64  `json:"mcs"`     // 802.11n MCS index
+	VHTMCS  int64  `json:"vht_mcs"` // 802.11ac MCS index
+	Width   string `json:"width"`   // Channel width, in MHz
+	ShortGI bool   `json:"shortgi"` // Short guard interval was used
+}
+
+// WifiStation is a device associated with an access point.
+// https://dev.freebox.fr/sdk/os/wifi/#wi-fi-station
+type WifiStation struct {
+	ID           string            `json:"id"`            // Station id
+	Mac          string            `json:"mac"`           // Station MAC address
+	BSSID        string            `json:"bssid"`         // BSS the station is associated with
+	Hostname     string            `json:"hostname"`      // Station hostname
+	Host         *LanInterfaceHost `json:"host"`          // LAN host information from the LAN browser
+	State        WifiStationState  `json:"state"`         // Station state
+	Inactive     int64             `json:"inactive"`      // Inactivity duration, in seconds
+	ConnDuration int64             `json:"conn_duration"` // Connection duration, in seconds
+	RXBytes      int64             `json:"rx_bytes"`      // Received bytes
+	TXBytes      int64             `json:"tx_bytes"`      // Transmitted bytes
+	RXRate       int64             `json:"rx_rate"`       // Reception rate, in byte/s
+	TXRate       int64             `json:"tx_rate"`       // Transmission rate, in byte/s
+	Signal       int64             `json:"signal"`        // Signal strength, in dBm
+	Flags        WifiStationFlags  `json:"flags"`         // Station capabilities
+	LastRX       WifiStationStats  `json:"last_rx"`       // Statistics of the last received frame
+	LastTX       WifiStationStats  `json:"last_tx"`       // Statistics of the last transmitted frame
+}
+
+// WifiBSSState is the state of a BSS.
+type WifiBSSState = string
+
+const (
+	WifiBSSStatePhyStopped WifiBSSState = "phy_stopped" // Parent access point is not active
+	WifiBSSStateNoParam    WifiBSSState = "no_param"    // No configuration available
+	WifiBSSStateBadParam   WifiBSSState = "bad_param"   // Invalid configuration
+	WifiBSSStateDisabled   WifiBSSState = "disabled"    // BSS is disabled
+	WifiBSSStateStarting   WifiBSSState = "starting"    // BSS is starting
+	WifiBSSStateActive     WifiBSSState = "active"      // BSS is active
+	WifiBSSStateFailed     WifiBSSState = "failed"      // BSS failed to start
+)
+
+// WifiEncryption is the encryption used by a BSS.
+type WifiEncryption = string
+
+const (
+	WifiEncryptionWEP          WifiEncryption = "wep"            // WEP
+	WifiEncryptionWPAPSKAuto   WifiEncryption = "wpa_psk_auto"   // WPA Personal (TKIP + AES)
+	WifiEncryptionWPAPSKTKIP   WifiEncryption = "wpa_psk_tkip"   // WPA Personal (TKIP)
+	WifiEncryptionWPAPSKCCMP   WifiEncryption = "wpa_psk_ccmp"   // WPA Personal (AES)
+	WifiEncryptionWPA12PSKAuto WifiEncryption = "wpa12_psk_auto" // WPA/WPA2 Personal (TKIP + AES)
+	WifiEncryptionWPA2PSKAuto  WifiEncryption = "wpa2_psk_auto"  // WPA2 Personal (TKIP + AES)
+	WifiEncryptionWPA2PSKTKIP  WifiEncryption = "wpa2_psk_tkip"  // WPA2 Personal (TKIP)
+	WifiEncryptionWPA2PSKCCMP  WifiEncryption = "wpa2_psk_ccmp"  // WPA2 Personal (AES)
+	WifiEncryptionWPA23PSKCCMP WifiEncryption = "wpa23_psk_ccmp" // WPA2/WPA3 Personal (AES)
+	WifiEncryptionWPA3SAE      WifiEncryption = "wpa3_sae"       // WPA3 Personal
+)
+
+// WifiBSSStatus is the current status of a BSS (read-only).
+type WifiBSSStatus struct {
+	State              WifiBSSState `json:"state"`                // State of the BSS
+	StaCount           int64        `json:"sta_count"`            // Number of associated stations
+	AuthorizedStaCount int64        `json:"authorized_sta_count"` // Number of authorized stations
+	IsMainBSS          bool         `json:"is_main_bss"`          // If true, this is the main BSS of the access point
+}
+
+// WifiBSSConfig is the configuration of a BSS.
+type WifiBSSConfig struct {
+	Enabled          bool           `json:"enabled"`                 // Enable the BSS
+	UseDefaultConfig bool           `json:"use_default_config"`      // Use the shared configuration of the main BSS
+	SSID             string         `json:"ssid,omitempty"`          // Network name
+	HideSSID         bool           `json:"hide_ssid"`               // Do not broadcast the SSID
+	Encryption       WifiEncryption `json:"encryption,omitempty"`    // Encryption type
+	Key              string         `json:"key,omitempty"`           // Network key
+	EAPOLVersion     int64          `json:"eapol_version,omitempty"` // EAPOL version to use
+}
+
+// WifiBSS is a Basic Service Set, i.e. a network broadcast by an access point.
+// https://dev.freebox.fr/sdk/os/wifi/#wi-fi-bss
+type WifiBSS struct {
+	ID     string        `json:"id"`     // BSSID (read-only)
+	PhyID  int64         `json:"phy_id"` // Parent access point id (read-only)
+	Status WifiBSSStatus `json:"status"` // Current status (read-only)
+	Config WifiBSSConfig `json:"config"` // Configuration of the BSS
+}
+
+// WifiNeighborCapabilities are the capabilities advertised by a neighbor network.
+type WifiNeighborCapabilities struct {
+	Legacy bool `json:"legacy"` // 802.11a/b/g
+	HT     bool `json:"ht"`     // 802.11n
+	VHT    bool `json:"vht"`    // 802.11ac
+}
+
+// WifiNeighbor is a network detected by an access point.
+type WifiNeighbor struct {
+	BSSID            string                   `json:"bssid"`             // Neighbor BSSID
+	SSID             string                   `json:"ssid"`              // Neighbor SSID
+	Band             WifiBand                 `json:"band"`              // Radio band
+	ChannelWidth     string                   `json:"channel_width"`     // Channel width, in MHz
+	Channel          int64                    `json:"channel"`           // Primary channel
+	SecondaryChannel int64                    `json:"secondary_channel"` // Secondary channel
+	Signal           int64                    `json:"signal"`            // Signal strength, in dBm
+	Capabilities     WifiNeighborCapabilities `json:"capabilities"`      // Advertised capabilities
+}
+
+// WifiChannelUsage is the usage of a channel as seen by an access point.
+type WifiChannelUsage struct {
+	Band          WifiBand `json:"band"`            // Radio band
+	Channel       int64    `json:"channel"`         // Channel number
+	NoiseLevel    int64    `json:"noise_level"`     // Noise level, in dBm
+	RXBusyPercent int64    `json:"rx_busy_percent"` // Percentage of time the channel is busy receiving
+	TXPercent     int64    `json:"tx_percent"`      // Percentage of time the channel is busy transmitting
+	BusyPercent   int64    `json:"busy_percent"`    // Percentage of time the channel is busy
+}
+
+// WifiPlanningMode is the state of Wi-Fi during a planning slot.
+type WifiPlanningMode = string
+
+const (
+	WifiPlanningModeOn  WifiPlanningMode = "on"  // Wi-Fi is on
+	WifiPlanningModeOff WifiPlanningMode = "off" // Wi-Fi is off
+)
+
+// WifiPlanning is the weekly Wi-Fi planning.
+type WifiPlanning struct {
+	UsePlanning bool               `json:"use_planning"`         // Enable the planning
+	Resolution  int64              `json:"resolution,omitempty"` // Number of slots in the week (read-only)
+	Mapping     []WifiPlanningMode `json:"mapping"`              // Mode for each slot, starting Monday midnight
+}
+
+// WifiMacFilterType is the type of a MAC filter entry.
+type WifiMacFilterType = string
+
+const (
+	WifiMacFilterTypeWhitelist WifiMacFilterType = "whitelist" // Allowed MAC address
+	WifiMacFilterTypeBlacklist WifiMacFilterType = "blacklist" // Rejected MAC address
+)
+
+// WifiMacFilterPayload is the create/update payload for a MAC filter entry.
+// Endpoint: POST /wifi/mac_filter/, PUT /wifi/mac_filter/{id}
+type WifiMacFilterPayload struct {
+	Mac     string            `json:"mac,omitempty"`  // MAC address to filter
+	Comment string            `json:"comment"`        // An optional comment
+	Type    WifiMacFilterType `json:"type,omitempty"` // Filter type
+}
+
+// WifiMacFilter is a MAC filter entry.
+type WifiMacFilter struct {
+	WifiMacFilterPayload
+	ID       string            `json:"id"`       // Entry id
+	Hostname string            `json:"hostname"` // Hostname matching the MAC address
+	Host     *LanInterfaceHost `json:"host"`     // LAN host information from the LAN browser
+}